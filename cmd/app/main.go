@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
 	"os"
@@ -12,52 +13,77 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"pr-reviewer-service/internal/config"
 	"pr-reviewer-service/internal/http/handlers"
-	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/notify"
 	"pr-reviewer-service/internal/service"
-	"pr-reviewer-service/internal/storage"
+	"pr-reviewer-service/internal/webhook"
 )
 
-func Run() error {
+func Run(cfg config.Config) error {
 	ctx := context.Background()
 
-	db, err := storage.NewPostgres(ctx)
+	repos, err := openStorage(ctx, cfg.Storage)
 	if err != nil {
 		return err
 	}
-	if err := storage.ApplyMigrations(ctx, db.Pool); err != nil {
-		log.Fatalf("cannot apply migrations: %v", err)
+	defer repos.close()
+
+	m := metrics.New()
+	if repos.stats != nil {
+		m.Register(repos.stats)
 	}
-	defer db.Close()
 
-	teamRepo := repository.NewTeamRepository(db.Pool)
-	userRepo := repository.NewUserRepository(db.Pool)
-	prRepo := repository.NewPRRepository(db.Pool)
+	teamService := service.NewTeamService(repos.teams, repos.tx, cfg.Reviewers.DefaultCount)
+	userService := service.NewUserService(repos.users, repos.tx)
+	prService := service.NewPRService(repos.prs, repos.users, repos.teams, repos.tx, repos.outbox, repos.events,
+		cfg.Reviewers.DefaultCount, m)
+	teamAdmin := service.NewTeamAdminService(repos.users, prService, repos.tx, repos.outbox)
+	slaService := service.NewSLAService(repos.escalations, prService, repos.outbox, repos.tx)
+
+	bgCtx, stopBackground := context.WithCancel(ctx)
+	defer stopBackground()
 
-	teamService := service.NewTeamService(teamRepo)
-	userService := service.NewUserService(userRepo)
-	prService := service.NewPRService(prRepo, userRepo)
-	teamAdmin := service.NewTeamAdminService(userRepo, prRepo)
+	relay := service.NewOutboxRelay(repos.outbox, repos.tx, notificationSinks(cfg.Notify)...)
+	go relay.Run(bgCtx, time.Duration(cfg.Outbox.RelayInterval))
+	go slaService.Run(bgCtx, time.Duration(cfg.SLA.CheckInterval))
 
-	server := handlers.NewServer(teamService, userService, prService, teamAdmin)
+	server := handlers.NewServer(teamService, userService, prService, teamAdmin, slaService)
 
 	router := chi.NewRouter()
 
 	router.Use(middleware.Logger)
+	router.Use(m.Middleware)
 	router.Use(middleware.Recoverer)
-	router.Use(middleware.AllowContentType("application/json"))
-	router.Post("/team/deactivate", server.PostTeamDeactivate)
-	router.Get("/stats", server.GetStats)
+	router.Use(middleware.AllowContentType("application/json", "text/calendar", "text/plain"))
+	// strict-обёртка читает тело целиком, поэтому размер ограничиваем здесь
+	router.Use(middleware.RequestSize(cfg.HTTP.MaxBodySize))
+
+	router.Get("/metrics", m.Handler().ServeHTTP)
+
+	// вебхуки проверяют подпись по сырому телу, поэтому они вне OpenAPI-обёртки
+	hooks := webhook.NewDispatcher(prService, userService)
+	if secret := cfg.Webhooks.GitHubSecret; secret != "" {
+		router.Post("/webhooks/github", webhook.NewGitHub(secret, hooks).ServeHTTP)
+	} else {
+		log.Println("webhooks.github_secret (GITHUB_WEBHOOK_SECRET) is not set, /webhooks/github is disabled")
+	}
+	if token := cfg.Webhooks.GitLabToken; token != "" {
+		router.Post("/webhooks/gitlab", webhook.NewGitLab(token, hooks).ServeHTTP)
+	} else {
+		log.Println("webhooks.gitlab_token (GITLAB_WEBHOOK_TOKEN) is not set, /webhooks/gitlab is disabled")
+	}
 
-	h := handlers.HandlerFromMux(server, router)
+	h := handlers.NewHandler(server, router)
 
 	srv := &http.Server{
-		Addr:    ":8080",
+		Addr:    cfg.HTTP.Addr,
 		Handler: h,
 	}
 
 	go func() {
-		log.Println("HTTP server started at :8080")
+		log.Printf("HTTP server started at %s", cfg.HTTP.Addr)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("server error: %v", err)
 		}
@@ -68,14 +94,70 @@ func Run() error {
 	<-stop
 
 	log.Println("shutting down...")
+	stopBackground()
 
-	ctxShutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctxShutdown, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.HTTP.ShutdownTimeout))
 	defer cancel()
 
 	return srv.Shutdown(ctxShutdown)
 }
+
+// notificationSinks — получатели уведомлений из cfg.Sinks. По умолчанию
+// webhook, если заданы адреса, иначе log. Имена и адреса уже проверены
+// config.Validate.
+func notificationSinks(cfg config.Notify) []service.Notifier {
+	names := cfg.Sinks
+	if len(names) == 0 {
+		names = []string{"log"}
+		if len(cfg.WebhookURLs) > 0 {
+			names = []string{"webhook"}
+		}
+	}
+
+	var sinks []service.Notifier
+	for _, name := range names {
+		switch name {
+		case "log":
+			sinks = append(sinks, service.LogNotifier{})
+		case "stdout":
+			sinks = append(sinks, service.NewWriterNotifier(os.Stdout))
+		case "webhook":
+			sinks = append(sinks, notify.NewWebhook(notify.Config{
+				URLs:   cfg.WebhookURLs,
+				Secret: cfg.WebhookSecret,
+			}))
+		}
+	}
+	return sinks
+}
+
 func main() {
-	if err := Run(); err != nil {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML-файл конфигурации (переменные окружения важнее)")
+	storageKind := flag.String("storage", "", "хранилище: db (PostgreSQL или SQLite из DB_DSN) или memory; важнее конфигурации")
+	printConfig := flag.Bool("print-config", false, "вывести итоговую конфигурацию (секреты скрыты) и выйти")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *storageKind != "" {
+		cfg.Storage.Kind = *storageKind
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	if *printConfig {
+		out, err := cfg.YAML()
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(out)
+		return
+	}
+
+	if err := Run(cfg); err != nil {
 		log.Fatal(err)
 	}
 }
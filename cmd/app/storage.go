@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"pr-reviewer-service/internal/config"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/repository/sqlite"
+	"pr-reviewer-service/internal/storage"
+)
+
+// repositories — реализации репозиториев выбранного хранилища.
+type repositories struct {
+	teams       repository.TeamRepository
+	users       repository.UserRepository
+	prs         repository.PRRepository
+	escalations repository.EscalationRepository
+	outbox      repository.OutboxRepository
+	events      repository.AssignmentEventRepository
+	tx          repository.Transactor
+
+	// stats — статистика соединений с БД для /metrics (nil для memory)
+	stats prometheus.Collector
+	close func()
+}
+
+// openStorage подключает хранилище cfg.Kind: memory (данные живут до остановки
+// процесса) или db — база cfg.DSN, PostgreSQL или SQLite по схеме DSN,
+// с миграциями.
+func openStorage(ctx context.Context, cfg config.Storage) (repositories, error) {
+	switch cfg.Kind {
+	case "db":
+		return openDB(ctx, cfg)
+	case "memory":
+		log.Println("using in-memory storage, data is lost on restart")
+		s := memory.NewStore()
+		return repositories{
+			teams:       memory.NewTeamRepository(s),
+			users:       memory.NewUserRepository(s),
+			prs:         memory.NewPRRepository(s),
+			escalations: memory.NewEscalationRepository(s),
+			outbox:      memory.NewOutboxRepository(s),
+			events:      memory.NewAssignmentEventRepository(s),
+			tx:          s,
+			close:       func() {},
+		}, nil
+	default:
+		return repositories{}, fmt.Errorf("unknown storage %q", cfg.Kind)
+	}
+}
+
+func openDB(ctx context.Context, cfg config.Storage) (repositories, error) {
+	driver, err := storage.DriverFor(cfg.DSN)
+	if err != nil {
+		return repositories{}, err
+	}
+
+	switch driver {
+	case storage.DriverSQLite:
+		db, err := storage.NewSQLite(ctx, cfg.DSN)
+		if err != nil {
+			return repositories{}, err
+		}
+		if err := storage.ApplySQLiteMigrations(ctx, db.DB); err != nil {
+			db.Close()
+			return repositories{}, fmt.Errorf("cannot apply migrations: %w", err)
+		}
+		return repositories{
+			teams:       sqlite.NewTeamRepository(db.DB),
+			users:       sqlite.NewUserRepository(db.DB),
+			prs:         sqlite.NewPRRepository(db.DB),
+			escalations: sqlite.NewEscalationRepository(db.DB),
+			outbox:      sqlite.NewOutboxRepository(db.DB),
+			events:      sqlite.NewAssignmentEventRepository(db.DB),
+			tx:          sqlite.NewTransactor(db.DB),
+			stats:       collectors.NewDBStatsCollector(db.DB, "sqlite"),
+			close:       db.Close,
+		}, nil
+	default:
+		db, err := storage.NewPostgres(ctx, cfg.DSN, storage.PoolConfig{
+			MaxConns:          cfg.MaxConns,
+			MinConns:          cfg.MinConns,
+			HealthCheckPeriod: time.Duration(cfg.HealthCheckPeriod),
+		})
+		if err != nil {
+			return repositories{}, err
+		}
+		if err := storage.ApplyMigrations(ctx, db.Pool); err != nil {
+			db.Close()
+			return repositories{}, fmt.Errorf("cannot apply migrations: %w", err)
+		}
+		return repositories{
+			teams:       repository.NewTeamRepository(db.Pool),
+			users:       repository.NewUserRepository(db.Pool),
+			prs:         repository.NewPRRepository(db.Pool),
+			escalations: repository.NewEscalationRepository(db.Pool),
+			outbox:      repository.NewOutboxRepository(db.Pool),
+			events:      repository.NewAssignmentEventRepository(db.Pool),
+			tx:          repository.NewTransactor(db.Pool),
+			stats:       metrics.NewPoolCollector(db.Pool),
+			close:       db.Close,
+		}, nil
+	}
+}
@@ -0,0 +1,117 @@
+// Команда migrate управляет схемой БД из конфигурации сервиса — storage.dsn
+// или DB_DSN (PostgreSQL или SQLite):
+//
+//	migrate [-config file] up        — применить все новые миграции (то же делает сервис при старте)
+//	migrate down [N]  — откатить N последних миграций (по умолчанию одну)
+//	migrate status    — список миграций и время их применения
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"pr-reviewer-service/internal/config"
+	"pr-reviewer-service/internal/storage"
+	"pr-reviewer-service/migrations"
+)
+
+func run(cfg config.Storage, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate [-config file] up | down [N] | status")
+	}
+	ctx := context.Background()
+
+	db, list, closeDB, err := open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	switch args[0] {
+	case "up":
+		n, err := storage.MigrateUp(ctx, db, list)
+		log.Printf("%d migration(s) applied", n)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		n, err := storage.MigrateDown(ctx, db, list, steps)
+		log.Printf("%d migration(s) reverted", n)
+		return err
+	case "status":
+		status, err := storage.MigrationsStatus(ctx, db, list)
+		if err != nil {
+			return err
+		}
+		for _, st := range status {
+			applied := "pending"
+			if st.AppliedAt != nil {
+				applied = st.AppliedAt.Format("2006-01-02 15:04:05Z07:00")
+			}
+			fmt.Printf("%03d %-30s %s\n", st.Version, st.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// open подключается к базе cfg.DSN и выбирает набор миграций для неё.
+func open(ctx context.Context, cfg config.Storage) (storage.MigrationDB, []storage.Migration, func(), error) {
+	if cfg.DSN == "" {
+		return nil, nil, nil, fmt.Errorf("storage.dsn (DB_DSN) is not set")
+	}
+	driver, err := storage.DriverFor(cfg.DSN)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if driver == storage.DriverSQLite {
+		list, err := storage.LoadMigrations(storage.SQLiteMigrationFiles())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		db, err := storage.NewSQLite(ctx, cfg.DSN)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return storage.SQLiteMigrations(db.DB), list, db.Close, nil
+	}
+
+	list, err := storage.LoadMigrations(migrations.FS)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	db, err := storage.NewPostgres(ctx, cfg.DSN, storage.PoolConfig{
+		MaxConns:          cfg.MaxConns,
+		MinConns:          cfg.MinConns,
+		HealthCheckPeriod: time.Duration(cfg.HealthCheckPeriod),
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return storage.PostgresMigrations(db.Pool), list, db.Close, nil
+}
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML-файл конфигурации (переменные окружения важнее)")
+	flag.Parse()
+
+	// остальные разделы конфигурации migrate не нужны, поэтому целиком её не проверяем
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := run(cfg.Storage, flag.Args()); err != nil {
+		log.Fatal(err)
+	}
+}
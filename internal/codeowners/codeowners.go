@@ -0,0 +1,177 @@
+// Package codeowners разбирает файлы владения в синтаксисе GitHub CODEOWNERS
+// и сопоставляет пути изменённых файлов с их владельцами.
+package codeowners
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var ErrMalformed = errors.New("malformed CODEOWNERS")
+
+// Owner — владелец из правила: @user, @org/team или email.
+// Для email ни User, ни Team не заполнены.
+type Owner struct {
+	Raw  string
+	User string
+	Team string
+}
+
+// Rule — строка файла: шаблон пути и его владельцы.
+// Правило без владельцев снимает владение, назначенное выше.
+type Rule struct {
+	Line    int
+	Pattern string
+	Owners  []Owner
+
+	re *regexp.Regexp
+}
+
+// Ruleset — правила в порядке файла; побеждает последнее совпавшее.
+type Ruleset []Rule
+
+// Parse читает файл CODEOWNERS.
+func Parse(r io.Reader) (Ruleset, error) {
+	var rules Ruleset
+
+	sc := bufio.NewScanner(r)
+	lineNum := 0
+	for sc.Scan() {
+		lineNum++
+		fields := strings.Fields(stripComment(sc.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := strings.ReplaceAll(fields[0], `\#`, "#")
+		re, err := compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrMalformed, lineNum, err)
+		}
+
+		rule := Rule{Line: lineNum, Pattern: pattern, re: re}
+		for _, f := range fields[1:] {
+			owner, err := parseOwner(f)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrMalformed, lineNum, err)
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+		rules = append(rules, rule)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Match возвращает владельцев пути по последнему совпавшему правилу.
+func (rs Ruleset) Match(path string) ([]Owner, bool) {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(rs) - 1; i >= 0; i-- {
+		if rs[i].re.MatchString(path) {
+			return rs[i].Owners, true
+		}
+	}
+	return nil, false
+}
+
+// stripComment отрезает комментарий: "#" в начале строки или после пробела.
+// Экранированный "\#" комментарием не считается.
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] != '#' {
+			continue
+		}
+		if i > 0 && line[i-1] == '\\' {
+			continue
+		}
+		if i == 0 || line[i-1] == ' ' || line[i-1] == '\t' {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func parseOwner(s string) (Owner, error) {
+	switch {
+	case strings.HasPrefix(s, "@"):
+		name := s[1:]
+		if name == "" {
+			return Owner{}, fmt.Errorf("empty owner %q", s)
+		}
+		// @org/team — в сервисе организаций нет, важна только команда
+		if _, team, ok := strings.Cut(name, "/"); ok {
+			if team == "" {
+				return Owner{}, fmt.Errorf("empty team in %q", s)
+			}
+			return Owner{Raw: s, Team: team}, nil
+		}
+		return Owner{Raw: s, User: name}, nil
+	case strings.Contains(s, "@"):
+		return Owner{Raw: s}, nil
+	default:
+		return Owner{}, fmt.Errorf("owner %q must be @user, @org/team or email", s)
+	}
+}
+
+// compile переводит шаблон в регулярное выражение по правилам CODEOWNERS:
+//   - "/" в начале или середине привязывает шаблон к корню, иначе он
+//     совпадает на любой глубине;
+//   - шаблон совпадает и с каталогом, и со всем его содержимым,
+//     кроме "dir/*" — он берёт только файлы непосредственно в dir;
+//   - "dir/" с "/" на конце — только каталог: файл с именем dir не совпадает;
+//   - "*" и "?" не переходят через "/", "**" — переходит.
+func compile(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, errors.New("negation is not supported")
+	}
+	if strings.HasPrefix(pattern, "[") {
+		return nil, errors.New("sections are not supported")
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	p := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("empty pattern %q", pattern)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored && !strings.HasPrefix(p, "**") {
+		b.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		b.WriteString("/.*")
+	case !strings.HasSuffix(p, "/*"):
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
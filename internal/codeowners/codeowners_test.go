@@ -0,0 +1,121 @@
+package codeowners
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// owners возвращает Raw владельцев пути; nil — путь без владельцев.
+func owners(t *testing.T, rs Ruleset, path string) []string {
+	t.Helper()
+	matched, _ := rs.Match(path)
+	var res []string
+	for _, o := range matched {
+		res = append(res, o.Raw)
+	}
+	return res
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+		path  string
+		want  []string
+	}{
+		{"unanchored name at the root", "docs @u1", "docs/index.md", []string{"@u1"}},
+		{"unanchored name at any depth", "docs @u1", "api/docs/index.md", []string{"@u1"}},
+		{"unanchored file name", "Makefile @u1", "tools/Makefile", []string{"@u1"}},
+		{"leading slash anchors to the root", "/docs @u1", "docs/index.md", []string{"@u1"}},
+		{"anchored pattern skips nested dirs", "/docs @u1", "api/docs/index.md", nil},
+		{"slash in the middle anchors", "api/v1 @u1", "internal/api/v1/handler.go", nil},
+		{"extension", "*.go @u1", "internal/service/pr.go", []string{"@u1"}},
+		{"star does not cross slash", "/api/*.go @u1", "api/v1/handler.go", nil},
+
+		{"dir/* takes direct children", "/api/* @u1", "api/handler.go", []string{"@u1"}},
+		{"dir/* skips nested files", "/api/* @u1", "api/v1/handler.go", nil},
+		{"dir/** takes nested files", "/api/** @u1", "api/v1/handler.go", []string{"@u1"}},
+		{"**/ matches any prefix", "**/testdata @u1", "a/b/testdata/x.json", []string{"@u1"}},
+		{"** in the middle", "/api/**/gen.go @u1", "api/v1/v2/gen.go", []string{"@u1"}},
+		{"** in the middle matches zero dirs", "/api/**/gen.go @u1", "api/gen.go", []string{"@u1"}},
+
+		{"dir/ matches its contents", "docs/ @u1", "docs/index.md", []string{"@u1"}},
+		{"dir/ does not match a file", "docs/ @u1", "docs", nil},
+		{"name without slash matches a file", "docs @u1", "docs", []string{"@u1"}},
+		{"question mark", "/v? @u1", "v1/x.go", []string{"@u1"}},
+		{"leading slash in the path", "/api/ @u1", "/api/x.go", []string{"@u1"}},
+
+		{"last match wins", "* @u1\n*.go @u2\n/api/ @u3", "api/x.go", []string{"@u3"}},
+		{"earlier rule when the last does not match", "* @u1\n/api/ @u3", "web/x.go", []string{"@u1"}},
+		{"rule without owners clears ownership", "* @u1\n/vendor/", "vendor/lib.go", nil},
+		{"cleared path is not inherited by siblings", "* @u1\n/vendor/", "main.go", []string{"@u1"}},
+		{"several owners", "*.go @u1 @org/backend dev@example.com", "x.go", []string{"@u1", "@org/backend", "dev@example.com"}},
+
+		{"escaped hash is part of the pattern", `/\#notes @u1`, "#notes/a.md", []string{"@u1"}},
+		{"comment after owners", "*.go @u1 # go code", "x.go", []string{"@u1"}},
+		{"commented out rule", "*.go @u1\n# *.go @u2", "x.go", []string{"@u1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := Parse(strings.NewReader(tt.rules))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := owners(t, rs, tt.path); !slices.Equal(got, tt.want) {
+				t.Errorf("owners of %s = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchReportsRuleWithoutOwners(t *testing.T) {
+	rs, err := Parse(strings.NewReader("* @u1\n/vendor/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := rs.Match("vendor/lib.go"); !ok || len(got) != 0 {
+		t.Errorf("vendor/lib.go: %v, %v; want a match with no owners", got, ok)
+	}
+	if _, ok := (Ruleset{}).Match("x.go"); ok {
+		t.Error("empty ruleset matched")
+	}
+}
+
+func TestParse(t *testing.T) {
+	rs, err := Parse(strings.NewReader("# owners\n\n*.go @u1 @org/backend\n  /docs/   dev@example.com  \n/\\#x @u2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 3 {
+		t.Fatalf("rules = %+v, want 3", rs)
+	}
+	if r := rs[0]; r.Line != 3 || r.Pattern != "*.go" ||
+		!slices.Equal(r.Owners, []Owner{{Raw: "@u1", User: "u1"}, {Raw: "@org/backend", Team: "backend"}}) {
+		t.Errorf("rule 0 = %+v", r)
+	}
+	if r := rs[1]; r.Line != 4 || r.Pattern != "/docs/" || !slices.Equal(r.Owners, []Owner{{Raw: "dev@example.com"}}) {
+		t.Errorf("rule 1 = %+v", r)
+	}
+	if r := rs[2]; r.Pattern != "/#x" {
+		t.Errorf("rule 2 pattern = %q, want the unescaped /#x", r.Pattern)
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	for _, rules := range []string{
+		"*.go u1",
+		"*.go @",
+		"*.go @org/",
+		"!*.go @u1",
+		"[Section] @u1",
+		"/ @u1",
+	} {
+		t.Run(rules, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(rules)); !errors.Is(err, ErrMalformed) {
+				t.Errorf("err = %v, want ErrMalformed", err)
+			}
+		})
+	}
+}
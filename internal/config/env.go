@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// envVar — переменная окружения и поле, которое она переопределяет.
+type envVar struct {
+	name string
+	set  func(c *Config, v string) error
+}
+
+// envVars — переменные окружения поверх файла. Имена, которые сервис
+// читал до появления конфигурации, сохранены.
+var envVars = []envVar{
+	{"HTTP_ADDR", func(c *Config, v string) error { c.HTTP.Addr = v; return nil }},
+	{"SHUTDOWN_TIMEOUT", func(c *Config, v string) error { return c.HTTP.ShutdownTimeout.UnmarshalText([]byte(v)) }},
+	{"MAX_BODY_SIZE", func(c *Config, v string) error { return parseInt(v, &c.HTTP.MaxBodySize) }},
+
+	{"STORAGE", func(c *Config, v string) error { c.Storage.Kind = v; return nil }},
+	{"DB_DSN", func(c *Config, v string) error { c.Storage.DSN = v; return nil }},
+	{"DB_MAX_CONNS", func(c *Config, v string) error { return parseInt(v, &c.Storage.MaxConns) }},
+	{"DB_MIN_CONNS", func(c *Config, v string) error { return parseInt(v, &c.Storage.MinConns) }},
+	{"DB_HEALTH_CHECK_PERIOD", func(c *Config, v string) error { return c.Storage.HealthCheckPeriod.UnmarshalText([]byte(v)) }},
+
+	{"DEFAULT_REVIEWER_COUNT", func(c *Config, v string) error { return parseInt(v, &c.Reviewers.DefaultCount) }},
+	{"SLA_CHECK_INTERVAL", func(c *Config, v string) error { return c.SLA.CheckInterval.UnmarshalText([]byte(v)) }},
+	{"OUTBOX_RELAY_INTERVAL", func(c *Config, v string) error { return c.Outbox.RelayInterval.UnmarshalText([]byte(v)) }},
+
+	{"NOTIFY_SINKS", func(c *Config, v string) error { c.Notify.Sinks = splitList(v); return nil }},
+	{"NOTIFY_WEBHOOK_URLS", func(c *Config, v string) error { c.Notify.WebhookURLs = splitList(v); return nil }},
+	{"NOTIFY_WEBHOOK_SECRET", func(c *Config, v string) error { c.Notify.WebhookSecret = v; return nil }},
+
+	{"GITHUB_WEBHOOK_SECRET", func(c *Config, v string) error { c.Webhooks.GitHubSecret = v; return nil }},
+	{"GITLAB_WEBHOOK_TOKEN", func(c *Config, v string) error { c.Webhooks.GitLabToken = v; return nil }},
+}
+
+// applyEnv переопределяет поля непустыми переменными окружения.
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	for _, e := range envVars {
+		v, ok := lookup(e.name)
+		if !ok || v == "" {
+			continue
+		}
+		if err := e.set(c, v); err != nil {
+			return fmt.Errorf("invalid %s %q: %w", e.name, v, err)
+		}
+	}
+	return nil
+}
+
+func parseInt[T int | int32 | int64](s string, dst *T) error {
+	v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return err
+	}
+	if int64(T(v)) != v {
+		return fmt.Errorf("value out of range")
+	}
+	*dst = T(v)
+	return nil
+}
+
+// splitList разбирает список через запятую, пропуская пустые элементы.
+func splitList(s string) []string {
+	var res []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
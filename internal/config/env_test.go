@@ -0,0 +1,109 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func lookupFrom(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	env := map[string]string{
+		"HTTP_ADDR":              ":9000",
+		"SHUTDOWN_TIMEOUT":       "15s",
+		"MAX_BODY_SIZE":          "1024",
+		"STORAGE":                "memory",
+		"DB_DSN":                 "sqlite://app.db",
+		"DB_MAX_CONNS":           "20",
+		"DB_MIN_CONNS":           "2",
+		"DB_HEALTH_CHECK_PERIOD": "1m",
+		"DEFAULT_REVIEWER_COUNT": "3",
+		"SLA_CHECK_INTERVAL":     "10m",
+		"OUTBOX_RELAY_INTERVAL":  " 500ms ",
+		"NOTIFY_SINKS":           "log,,webhook ",
+		"NOTIFY_WEBHOOK_URLS":    "http://a, http://b",
+		"NOTIFY_WEBHOOK_SECRET":  "notify",
+		"GITHUB_WEBHOOK_SECRET":  "gh",
+		"GITLAB_WEBHOOK_TOKEN":   "gl",
+	}
+	if len(env) != len(envVars) {
+		t.Fatalf("test covers %d variables, envVars has %d", len(env), len(envVars))
+	}
+
+	c := Default()
+	if err := c.applyEnv(lookupFrom(env)); err != nil {
+		t.Fatal(err)
+	}
+	want := Config{
+		HTTP: HTTP{Addr: ":9000", ShutdownTimeout: Duration(15 * time.Second), MaxBodySize: 1024},
+		Storage: Storage{
+			Kind: "memory", DSN: "sqlite://app.db", MaxConns: 20, MinConns: 2,
+			HealthCheckPeriod: Duration(time.Minute),
+		},
+		Reviewers: Reviewers{DefaultCount: 3},
+		SLA:       SLA{CheckInterval: Duration(10 * time.Minute)},
+		Outbox:    Outbox{RelayInterval: Duration(500 * time.Millisecond)},
+		Notify: Notify{
+			Sinks: []string{"log", "webhook"}, WebhookURLs: []string{"http://a", "http://b"},
+			WebhookSecret: "notify",
+		},
+		Webhooks: Webhooks{GitHubSecret: "gh", GitLabToken: "gl"},
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("applyEnv:\n got %+v\nwant %+v", c, want)
+	}
+}
+
+// Пустая переменная не затирает значение из файла.
+func TestApplyEnvSkipsEmpty(t *testing.T) {
+	c := Default()
+	c.HTTP.Addr = ":9000"
+	if err := c.applyEnv(lookupFrom(map[string]string{"HTTP_ADDR": "", "DB_MAX_CONNS": ""})); err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTP.Addr != ":9000" || c.Storage.MaxConns != Default().Storage.MaxConns {
+		t.Errorf("config = %+v", c)
+	}
+}
+
+func TestApplyEnvInvalid(t *testing.T) {
+	for name, value := range map[string]string{
+		"SHUTDOWN_TIMEOUT":       "5",
+		"MAX_BODY_SIZE":          "5MB",
+		"DB_MAX_CONNS":           "3000000000", // не помещается в int32
+		"DB_MIN_CONNS":           "-",
+		"DB_HEALTH_CHECK_PERIOD": "soon",
+		"DEFAULT_REVIEWER_COUNT": "2.5",
+		"OUTBOX_RELAY_INTERVAL":  "1 hour",
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := Default()
+			err := c.applyEnv(lookupFrom(map[string]string{name: value}))
+			if err == nil || !strings.Contains(err.Error(), name) {
+				t.Errorf("err = %v, want it to name %s", err, name)
+			}
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := map[string][]string{
+		"":             nil,
+		" , ,":         nil,
+		"log":          {"log"},
+		" log, stdout": {"log", "stdout"},
+		"a,,b,":        {"a", "b"},
+	}
+	for in, want := range tests {
+		if got := splitList(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitList(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// ---------------- ASSIGNMENT EVENTS -----------------
+
+type AssignmentAction string
+
+const (
+	ActionAssigned   AssignmentAction = "assigned"
+	ActionUnassigned AssignmentAction = "unassigned"
+)
+
+// AssignmentReason — почему ревьювер назначен или снят.
+type AssignmentReason string
+
+const (
+	// ReasonAuto — автоназначение при создании PR, переводе из черновика
+	// или повторном открытии.
+	ReasonAuto          AssignmentReason = "auto"
+	ReasonCodeowner     AssignmentReason = "codeowner"
+	ReasonReassign      AssignmentReason = "reassign"
+	ReasonDeactivation  AssignmentReason = "deactivation"
+	ReasonSLAEscalation AssignmentReason = "sla_escalation"
+	ReasonPRClosed      AssignmentReason = "pr_closed"
+	ReasonPRDraft       AssignmentReason = "pr_draft"
+)
+
+// AssignmentEvent — запись журнала назначений (только добавление).
+// Actor — кто инициировал изменение, Detail — подробности для человека.
+type AssignmentEvent struct {
+	ID        int64
+	PRID      string
+	UserID    string
+	Action    AssignmentAction
+	Reason    AssignmentReason
+	Detail    string
+	Actor     string
+	CreatedAt time.Time
+}
@@ -13,4 +13,21 @@ var (
 	ErrNoCandidate   = errors.New("no candidate for reviewer")
 	ErrNotAssigned   = errors.New("reviewer not assigned to this PR")
 	ErrTeamExists    = errors.New("team already exists")
+
+	ErrUnknownStrategy    = errors.New("unknown reviewer strategy")
+	ErrInvalidSettings    = errors.New("invalid team settings")
+	ErrInvalidDecision    = errors.New("unknown review decision")
+	ErrNotEnoughApprovals = errors.New("not enough approvals to merge")
+	ErrInvalidTransition  = errors.New("invalid pull request status transition")
+	ErrPRNotOpen          = errors.New("pull request is not open")
+	ErrAbsenceNotFound    = errors.New("absence not found")
+	ErrInvalidAbsence     = errors.New("absence must end after it starts")
+	ErrInvalidCalendar    = errors.New("invalid iCalendar file")
+	ErrInvalidCodeowners  = errors.New("invalid CODEOWNERS file")
+	ErrUnknownProvider    = errors.New("unknown provider")
+	ErrInvalidPeriod      = errors.New("period must end after it starts")
+
+	// ErrUndeliverable — получатель отверг уведомление так, что повтор
+	// не поможет (например, 4xx кроме 429); outbox relay его не повторяет.
+	ErrUndeliverable = errors.New("notification rejected by the receiver")
 )
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// ---------------- REVIEW SLA -----------------
+
+type EscalationKind string
+
+const (
+	EscalationReminder EscalationKind = "reminder"
+	EscalationReassign EscalationKind = "reassign"
+)
+
+// Escalation — напоминание или переназначение ревьювера по SLA.
+// AssignedAt — время назначения, с которого шёл отсчёт.
+type Escalation struct {
+	ID         int64
+	PRID       string
+	ReviewerID string
+	Kind       EscalationKind
+	AssignedAt time.Time
+	ReplacedBy string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// OverdueReview — назначение без решения на OPEN PR, превысившее SLA
+// команды автора. Reminded — напоминание по нему уже отправлено.
+type OverdueReview struct {
+	PRID          string
+	ReviewerID    string
+	TeamName      string
+	AssignedAt    time.Time
+	SLA           time.Duration
+	EscalateAfter time.Duration
+	Reminded      bool
+}
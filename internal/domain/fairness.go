@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultFairnessWeeks — окно отчёта о равномерности, если период не задан.
+const DefaultFairnessWeeks = 12
+
+// Пороги отметки перегруженных и недогруженных: неделя считается
+// перекосом, если назначений у участника больше (меньше) среднего по команде
+// за эту неделю более чем на FairnessTolerance; отметка ставится, если таких
+// недель не меньше FairnessMinShare от недель с назначениями в команде.
+const (
+	FairnessTolerance = 0.25
+	FairnessMinShare  = 0.5
+)
+
+type FairnessFlag string
+
+const (
+	FairnessOver  FairnessFlag = "over"
+	FairnessUnder FairnessFlag = "under"
+)
+
+// AssignmentCount — число назначений пользователя за неделю, начинающуюся
+// в Week (понедельник, UTC). Нулевой Week — назначений в окне нет.
+type AssignmentCount struct {
+	UserID   string
+	TeamName string
+	Week     time.Time
+	Count    int
+}
+
+type MemberFairness struct {
+	UserID      string
+	Assignments int
+	// Deviation — отклонение от среднего по команде за всё окно.
+	Deviation  float64
+	OverWeeks  int
+	UnderWeeks int
+	Flag       FairnessFlag
+}
+
+type TeamFairness struct {
+	TeamName    string
+	Assignments int
+	Mean        float64
+	Gini        float64
+	Members     []MemberFairness
+}
+
+type FairnessReport struct {
+	From  time.Time
+	To    time.Time
+	Weeks int
+	Teams []TeamFairness
+}
+
+// WeekStart — понедельник недели t в UTC, как date_trunc('week', ...).
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+// NewFairnessReport строит отчёт по недельным счётчикам. counts должны
+// содержать хотя бы одну строку на каждого учитываемого участника.
+func NewFairnessReport(counts []AssignmentCount, from, to time.Time) FairnessReport {
+	var weeks []time.Time
+	for w := WeekStart(from); w.Before(to); w = w.AddDate(0, 0, 7) {
+		weeks = append(weeks, w)
+	}
+	report := FairnessReport{From: from, To: to, Weeks: len(weeks)}
+
+	type member struct {
+		id     string
+		weekly map[time.Time]int
+	}
+	var teamOrder []string
+	teams := map[string][]*member{}
+	index := map[string]*member{}
+
+	for _, c := range counts {
+		m, ok := index[c.UserID]
+		if !ok {
+			m = &member{id: c.UserID, weekly: map[time.Time]int{}}
+			index[c.UserID] = m
+			if _, seen := teams[c.TeamName]; !seen {
+				teamOrder = append(teamOrder, c.TeamName)
+			}
+			teams[c.TeamName] = append(teams[c.TeamName], m)
+		}
+		if !c.Week.IsZero() {
+			m.weekly[WeekStart(c.Week)] += c.Count
+		}
+	}
+
+	for _, name := range teamOrder {
+		members := teams[name]
+		n := float64(len(members))
+		tf := TeamFairness{TeamName: name}
+
+		totals := make([]int, len(members))
+		for i, m := range members {
+			for _, w := range weeks {
+				totals[i] += m.weekly[w]
+			}
+			tf.Assignments += totals[i]
+		}
+		tf.Mean = float64(tf.Assignments) / n
+		tf.Gini = Gini(totals)
+
+		over := make([]int, len(members))
+		under := make([]int, len(members))
+		activeWeeks := 0
+		for _, w := range weeks {
+			sum := 0
+			for _, m := range members {
+				sum += m.weekly[w]
+			}
+			if sum == 0 {
+				continue
+			}
+			activeWeeks++
+			mean := float64(sum) / n
+			for i, m := range members {
+				x := float64(m.weekly[w])
+				switch {
+				case x > mean*(1+FairnessTolerance):
+					over[i]++
+				case x < mean*(1-FairnessTolerance):
+					under[i]++
+				}
+			}
+		}
+
+		for i, m := range members {
+			mf := MemberFairness{
+				UserID:      m.id,
+				Assignments: totals[i],
+				Deviation:   float64(totals[i]) - tf.Mean,
+				OverWeeks:   over[i],
+				UnderWeeks:  under[i],
+			}
+			if activeWeeks > 0 {
+				switch {
+				case float64(over[i]) >= FairnessMinShare*float64(activeWeeks) && mf.Deviation > 0:
+					mf.Flag = FairnessOver
+				case float64(under[i]) >= FairnessMinShare*float64(activeWeeks) && mf.Deviation < 0:
+					mf.Flag = FairnessUnder
+				}
+			}
+			tf.Members = append(tf.Members, mf)
+		}
+		report.Teams = append(report.Teams, tf)
+	}
+	return report
+}
+
+// Gini — коэффициент Джини: 0 — назначения поровну, ближе к 1 — почти всё
+// у одного участника.
+func Gini(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	if sum == 0 {
+		return 0
+	}
+	n := float64(len(sorted))
+	return 2*weighted/(n*sum) - (n+1)/n
+}
@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d, h int) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestWeekStart(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	newYork := time.FixedZone("EDT", -4*60*60)
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{"monday midnight", date(2026, 10, 19, 0), date(2026, 10, 19, 0)},
+		{"sunday before midnight", time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC), date(2026, 10, 12, 0)},
+		{"midweek", date(2026, 10, 15, 12), date(2026, 10, 12, 0)},
+		// понедельник по местному времени, но ещё воскресенье в UTC
+		{"monday in MSK is sunday in UTC", time.Date(2026, 10, 19, 1, 0, 0, 0, moscow), date(2026, 10, 12, 0)},
+		// воскресенье по местному времени, но уже понедельник в UTC
+		{"sunday in EDT is monday in UTC", time.Date(2026, 10, 18, 22, 0, 0, 0, newYork), date(2026, 10, 19, 0)},
+		{"across month", date(2026, 11, 1, 10), date(2026, 10, 26, 0)},
+		{"across year", date(2027, 1, 2, 0), date(2026, 12, 28, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeekStart(tt.t); !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("WeekStart(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   float64
+	}{
+		{"empty", nil, 0},
+		{"all zero", []int{0, 0, 0}, 0},
+		{"all equal", []int{5, 5, 5, 5}, 0},
+		{"single member", []int{7}, 0},
+		{"one has everything", []int{0, 12, 0, 0}, 0.75},
+		{"two of two", []int{0, 3}, 0.5},
+		{"uneven", []int{1, 2, 3}, 2.0 / 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Gini(tt.values); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Gini(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+// weekly раскладывает недельные счётчики участника по неделям, начиная с from.
+func weekly(user, team string, from time.Time, perWeek ...int) []AssignmentCount {
+	var res []AssignmentCount
+	for i, n := range perWeek {
+		res = append(res, AssignmentCount{UserID: user, TeamName: team, Week: from.AddDate(0, 0, 7*i), Count: n})
+	}
+	return res
+}
+
+func concat(parts ...[]AssignmentCount) []AssignmentCount {
+	var res []AssignmentCount
+	for _, p := range parts {
+		res = append(res, p...)
+	}
+	return res
+}
+
+func TestNewFairnessReport(t *testing.T) {
+	from := date(2026, 9, 28, 0) // понедельник
+	to := from.AddDate(0, 0, 28)
+
+	type member struct {
+		id          string
+		assignments int
+		over, under int
+		flag        FairnessFlag
+	}
+	tests := []struct {
+		name        string
+		counts      []AssignmentCount
+		assignments int
+		gini        float64
+		members     []member
+	}{
+		{
+			name: "equal load",
+			counts: concat(
+				weekly("u1", "backend", from, 2, 2, 2, 2),
+				weekly("u2", "backend", from, 2, 2, 2, 2),
+				weekly("u3", "backend", from, 2, 2, 2, 2),
+			),
+			assignments: 24,
+			gini:        0,
+			members:     []member{{id: "u1", assignments: 8}, {id: "u2", assignments: 8}, {id: "u3", assignments: 8}},
+		},
+		{
+			name: "one reviewer takes everything",
+			counts: concat(
+				weekly("u1", "backend", from, 3, 3, 3, 3),
+				[]AssignmentCount{{UserID: "u2", TeamName: "backend"}},
+				[]AssignmentCount{{UserID: "u3", TeamName: "backend"}},
+			),
+			assignments: 12,
+			gini:        2.0 / 3,
+			members: []member{
+				{id: "u1", assignments: 12, over: 4, flag: FairnessOver},
+				{id: "u2", under: 4, flag: FairnessUnder},
+				{id: "u3", under: 4, flag: FairnessUnder},
+			},
+		},
+		{
+			// нет назначений: ни NaN, ни отметок
+			name: "zero load",
+			counts: []AssignmentCount{
+				{UserID: "u1", TeamName: "backend"},
+				{UserID: "u2", TeamName: "backend"},
+			},
+			members: []member{{id: "u1"}, {id: "u2"}},
+		},
+		{
+			// перекос в половине недель с назначениями — отметка ставится
+			name: "overloaded in half of the weeks",
+			counts: concat(
+				weekly("u1", "backend", from, 4, 2, 0, 4),
+				weekly("u2", "backend", from, 2, 2, 0, 2),
+			),
+			assignments: 16,
+			gini:        0.125,
+			members: []member{
+				{id: "u1", assignments: 10, over: 2, flag: FairnessOver},
+				{id: "u2", assignments: 6, under: 2, flag: FairnessUnder},
+			},
+		},
+		{
+			// перекос в одной неделе из трёх — без отметки
+			name: "overloaded in one week",
+			counts: concat(
+				weekly("u1", "backend", from, 4, 2, 2),
+				weekly("u2", "backend", from, 2, 2, 2),
+			),
+			assignments: 14,
+			gini:        1.0 / 14,
+			members: []member{
+				{id: "u1", assignments: 8, over: 1},
+				{id: "u2", assignments: 6, under: 1},
+			},
+		},
+		{
+			// отклонение в пределах FairnessTolerance не считается перекосом
+			name: "within tolerance",
+			counts: concat(
+				weekly("u1", "backend", from, 5, 5, 5, 5),
+				weekly("u2", "backend", from, 4, 4, 4, 4),
+			),
+			assignments: 36,
+			gini:        1.0 / 18,
+			members:     []member{{id: "u1", assignments: 20}, {id: "u2", assignments: 16}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewFairnessReport(tt.counts, from, to)
+			if report.Weeks != 4 || len(report.Teams) != 1 {
+				t.Fatalf("report = %+v", report)
+			}
+			team := report.Teams[0]
+			if team.Assignments != tt.assignments || math.Abs(team.Gini-tt.gini) > 1e-9 {
+				t.Errorf("team: assignments %d gini %v, want %d %v", team.Assignments, team.Gini, tt.assignments, tt.gini)
+			}
+			if math.IsNaN(team.Mean) || math.IsNaN(team.Gini) {
+				t.Errorf("team has NaN: %+v", team)
+			}
+			if len(team.Members) != len(tt.members) {
+				t.Fatalf("members = %+v", team.Members)
+			}
+			for i, want := range tt.members {
+				got := team.Members[i]
+				if got.UserID != want.id || got.Assignments != want.assignments ||
+					got.OverWeeks != want.over || got.UnderWeeks != want.under || got.Flag != want.flag {
+					t.Errorf("member %d = %+v, want %+v", i, got, want)
+				}
+				if wantDev := float64(want.assignments) - team.Mean; math.IsNaN(got.Deviation) || got.Deviation != wantDev {
+					t.Errorf("member %s deviation = %v, want %v", got.UserID, got.Deviation, wantDev)
+				}
+			}
+		})
+	}
+}
+
+// Недели режутся по понедельнику UTC, а назначения вне окна не учитываются.
+func TestNewFairnessReportWeekBoundaries(t *testing.T) {
+	from := date(2026, 10, 7, 12) // среда: окно начинается с понедельника 5-го
+	to := date(2026, 10, 19, 0)   // понедельник, в окно не входит
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	counts := []AssignmentCount{
+		{UserID: "u1", TeamName: "backend", Week: date(2026, 10, 5, 0), Count: 1},
+		// воскресенье 23:00 UTC — ещё вторая неделя окна
+		{UserID: "u1", TeamName: "backend", Week: date(2026, 10, 18, 23), Count: 2},
+		// понедельник по Москве, но воскресенье в UTC — тоже вторая неделя
+		{UserID: "u1", TeamName: "backend", Week: time.Date(2026, 10, 19, 1, 0, 0, 0, moscow), Count: 4},
+		// понедельник 00:00 UTC — уже за окном
+		{UserID: "u1", TeamName: "backend", Week: date(2026, 10, 19, 0), Count: 8},
+		// неделя до окна
+		{UserID: "u1", TeamName: "backend", Week: date(2026, 10, 4, 23), Count: 16},
+		{UserID: "u2", TeamName: "frontend", Week: date(2026, 10, 12, 0), Count: 32},
+	}
+	report := NewFairnessReport(counts, from, to)
+	if report.Weeks != 2 || !report.From.Equal(from) || !report.To.Equal(to) {
+		t.Errorf("window = %v..%v, %d weeks", report.From, report.To, report.Weeks)
+	}
+	if len(report.Teams) != 2 {
+		t.Fatalf("teams = %+v", report.Teams)
+	}
+	if got := report.Teams[0]; got.TeamName != "backend" || got.Assignments != 7 {
+		t.Errorf("backend = %+v, want 7 assignments", got)
+	}
+	if got := report.Teams[1]; got.TeamName != "frontend" || got.Assignments != 32 {
+		t.Errorf("frontend = %+v, want 32 assignments", got)
+	}
+}
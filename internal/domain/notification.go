@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// ---------------- NOTIFICATIONS -----------------
+
+type NotificationKind string
+
+const (
+	NotifyReviewerAssigned   NotificationKind = "reviewer.assigned"
+	NotifyReviewerReassigned NotificationKind = "reviewer.reassigned"
+	NotifyPRMerged           NotificationKind = "pr.merged"
+	NotifyTeamDeactivated    NotificationKind = "team.deactivated"
+	NotifyReviewReminder     NotificationKind = "review.reminder"
+	NotifyReviewEscalated    NotificationKind = "review.escalated"
+)
+
+// Notification — событие для внешних получателей (чаты, боты).
+// ID — идентификатор записи outbox: доставка «хотя бы один раз»,
+// повторы получатель отбрасывает по нему. UserID — кому адресовано;
+// ReplacedBy заполнен при переназначении, TeamName и UserIDs — при
+// деактивации команды.
+type Notification struct {
+	ID         string           `json:"id"`
+	Kind       NotificationKind `json:"kind"`
+	PRID       string           `json:"pull_request_id,omitempty"`
+	UserID     string           `json:"user_id,omitempty"`
+	ReplacedBy string           `json:"replaced_by,omitempty"`
+	TeamName   string           `json:"team_name,omitempty"`
+	UserIDs    []string         `json:"user_ids,omitempty"`
+	Reason     string           `json:"reason,omitempty"`
+	At         time.Time        `json:"at"`
+}
+
+// OutboxEvent — неотправленное уведомление из outbox.
+// Attempts — сколько раз доставка уже не удалась.
+type OutboxEvent struct {
+	Notification
+	Attempts int
+}
@@ -7,27 +7,89 @@ import "time"
 type PRStatus string
 
 const (
+	PRStatusDraft  PRStatus = "DRAFT"
 	PRStatusOpen   PRStatus = "OPEN"
 	PRStatusMerged PRStatus = "MERGED"
+	PRStatusClosed PRStatus = "CLOSED"
 )
 
+// prTransitions — допустимые переходы жизненного цикла PR.
+// MERGED — конечное состояние.
+var prTransitions = map[PRStatus][]PRStatus{
+	PRStatusDraft:  {PRStatusOpen, PRStatusClosed},
+	PRStatusOpen:   {PRStatusMerged, PRStatusClosed, PRStatusDraft},
+	PRStatusClosed: {PRStatusOpen},
+}
+
+func (s PRStatus) CanTransitionTo(next PRStatus) bool {
+	for _, st := range prTransitions[s] {
+		if st == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ---------------- REVIEW DECISION -----------------
+
+type ReviewDecision string
+
+const (
+	DecisionApproved         ReviewDecision = "APPROVED"
+	DecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+	DecisionCommented        ReviewDecision = "COMMENTED"
+)
+
+func (d ReviewDecision) Valid() bool {
+	switch d {
+	case DecisionApproved, DecisionChangesRequested, DecisionCommented:
+		return true
+	}
+	return false
+}
+
+// Review — назначенный ревьювер и его решение (пустое, пока не высказался).
+// FallbackTeam заполнена, если ревьювер взят не из команды автора.
+type Review struct {
+	ReviewerID   string
+	Decision     ReviewDecision
+	DecidedAt    *time.Time
+	FallbackTeam string
+}
+
 // ---------------- FULL PR -----------------
 
+// PullRequest не сериализуется напрямую: в API он попадает через
+// toPullRequest (pull_request_id, assigned_reviewers по спецификации).
 type PullRequest struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	AuthorID  string     `json:"author_id"`
-	Status    PRStatus   `json:"status"`
-	Reviewers []string   `json:"reviewers"`
-	CreatedAt time.Time  `json:"created_at"`
-	MergedAt  *time.Time `json:"merged_at"`
+	ID        string
+	Name      string
+	AuthorID  string
+	Status    PRStatus
+	Reviewers []string
+	Reviews   []Review
+	Files     []string
+	CreatedAt time.Time
+	MergedAt  *time.Time
+	ClosedAt  *time.Time
+}
+
+// Approvals — число ревьюверов, одобривших PR.
+func (pr PullRequest) Approvals() int {
+	n := 0
+	for _, r := range pr.Reviews {
+		if r.Decision == DecisionApproved {
+			n++
+		}
+	}
+	return n
 }
 
 // ---------------- SHORT PR (для списка ревьюверов) -----------------
 
 type PullRequestShort struct {
-	ID       string   `json:"id"`
-	Name     string   `json:"name"`
-	AuthorID string   `json:"author_id"`
-	Status   PRStatus `json:"status"`
+	ID       string
+	Name     string
+	AuthorID string
+	Status   PRStatus
 }
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// ---------------- STATS FILTER -----------------
+
+// StatsFilter — срез статистики. Пустой Team — все команды; From/To
+// ограничивают PR по времени создания, полуинтервал [From, To).
+type StatsFilter struct {
+	Team string
+	From *time.Time
+	To   *time.Time
+}
+
+func (f StatsFilter) Valid() bool {
+	return f.From == nil || f.To == nil || f.From.Before(*f.To)
+}
+
+// ---------------- STATS -----------------
+
+// UserStats — назначения и авторство одного пользователя.
+// Assignments включает назначения на PR в любом статусе.
+type UserStats struct {
+	UserID            string
+	TeamName          string
+	Assignments       int
+	OpenAssignments   int
+	MergedAssignments int
+	AuthoredPRs       int
+}
+
+// TeamStats — сумма UserStats по участникам команды.
+type TeamStats struct {
+	TeamName          string
+	Assignments       int
+	OpenAssignments   int
+	MergedAssignments int
+	AuthoredPRs       int
+}
+
+type Stats struct {
+	PRStatus map[PRStatus]int
+	Users    []UserStats
+}
+
+// Teams сворачивает пользовательскую статистику по командам
+// в порядке первого появления команды в Users.
+func (s Stats) Teams() []TeamStats {
+	var res []TeamStats
+	idx := map[string]int{}
+	for _, u := range s.Users {
+		i, ok := idx[u.TeamName]
+		if !ok {
+			i = len(res)
+			idx[u.TeamName] = i
+			res = append(res, TeamStats{TeamName: u.TeamName})
+		}
+		t := &res[i]
+		t.Assignments += u.Assignments
+		t.OpenAssignments += u.OpenAssignments
+		t.MergedAssignments += u.MergedAssignments
+		t.AuthoredPRs += u.AuthoredPRs
+	}
+	return res
+}
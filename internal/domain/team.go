@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type Team struct {
 	Name    string
 	Members []TeamMember
@@ -10,3 +12,57 @@ type TeamMember struct {
 	Username string
 	IsActive bool
 }
+
+// ---------------- REVIEWER STRATEGY -----------------
+
+type ReviewerStrategy string
+
+const (
+	StrategyRandom      ReviewerStrategy = "random"
+	StrategyRoundRobin  ReviewerStrategy = "round_robin"
+	StrategyLeastLoaded ReviewerStrategy = "least_loaded"
+	StrategyWeighted    ReviewerStrategy = "weighted"
+)
+
+// ---------------- TEAM SETTINGS -----------------
+
+const (
+	// DefaultReviewerCount — значение по умолчанию для reviewers.default_count в конфигурации.
+	DefaultReviewerCount = 2
+	MaxReviewerCount     = 10
+)
+
+// AnyTeam в цепочке fallback означает «любой активный пользователь».
+const AnyTeam = "*"
+
+type TeamSettings struct {
+	TeamName         string
+	ReviewerStrategy ReviewerStrategy
+	ReviewerCount    int
+	MinApprovals     int
+	// FallbackTeams — откуда по порядку добирать ревьюверов,
+	// если в своей команде кандидатов не хватило.
+	FallbackTeams []string
+	// ReviewSLAHours — через сколько часов без решения напомнить ревьюверу,
+	// EscalationHours — когда заменить его другим; 0 — выключено.
+	ReviewSLAHours  int
+	EscalationHours int
+}
+
+// DefaultTeamSettings — настройки команды, для которой ничего не сохранено.
+func DefaultTeamSettings(team string, reviewerCount int) TeamSettings {
+	return TeamSettings{
+		TeamName:         team,
+		ReviewerStrategy: StrategyRandom,
+		ReviewerCount:    reviewerCount,
+	}
+}
+
+// ---------------- CODEOWNERS -----------------
+
+// TeamCodeowners — загруженный командой файл владения (синтаксис CODEOWNERS).
+type TeamCodeowners struct {
+	TeamName  string
+	Content   string
+	UpdatedAt time.Time
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// ---------------- SAMPLES -----------------
+
+// DurationSample — одно измерение времени с привязкой к пользователю
+// и его команде.
+type DurationSample struct {
+	UserID   string
+	TeamName string
+	Duration time.Duration
+}
+
+// TurnaroundSamples — сырые измерения для метрик скорости ревью.
+type TurnaroundSamples struct {
+	// Merges — смерженные PR, от создания до merge; UserID — автор.
+	Merges []DurationSample
+	// ReviewerMerges — те же PR, по одному измерению на каждого
+	// назначенного ревьювера; UserID — ревьювер.
+	ReviewerMerges []DurationSample
+	// Reviews — назначения с решением, от назначения до первого решения.
+	Reviews []DurationSample
+}
+
+// ---------------- PERCENTILES -----------------
+
+type Percentiles struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+}
+
+// NewPercentiles считает перцентили методом ближайшего ранга.
+// samples сортируется на месте.
+func NewPercentiles(samples []time.Duration) Percentiles {
+	if len(samples) == 0 {
+		return Percentiles{}
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	rank := func(p float64) time.Duration {
+		i := int(math.Ceil(p*float64(len(samples)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return samples[i]
+	}
+	return Percentiles{
+		Count: len(samples),
+		P50:   rank(0.50),
+		P90:   rank(0.90),
+		P99:   rank(0.99),
+	}
+}
+
+// ---------------- TURNAROUND -----------------
+
+// TeamTurnaround — TimeToMerge по PR авторов команды,
+// TimeInReview по назначениям её участников.
+type TeamTurnaround struct {
+	TeamName     string
+	TimeToMerge  Percentiles
+	TimeInReview Percentiles
+}
+
+// ReviewerTurnaround — TimeToMerge по смерженным PR, где пользователь
+// был ревьювером, TimeInReview по его собственным назначениям.
+type ReviewerTurnaround struct {
+	UserID       string
+	TeamName     string
+	TimeToMerge  Percentiles
+	TimeInReview Percentiles
+}
+
+type Turnaround struct {
+	Teams     []TeamTurnaround
+	Reviewers []ReviewerTurnaround
+}
+
+// Aggregate сворачивает измерения в перцентили по командам и ревьюверам.
+// Порядок — по первому появлению команды или ревьювера в измерениях.
+func (s TurnaroundSamples) Aggregate() Turnaround {
+	type bucket struct {
+		team          string
+		merge, review []time.Duration
+	}
+	var teamOrder, userOrder []string
+	teams := map[string]*bucket{}
+	users := map[string]*bucket{}
+
+	get := func(m map[string]*bucket, order *[]string, key, team string) *bucket {
+		b, ok := m[key]
+		if !ok {
+			b = &bucket{team: team}
+			m[key] = b
+			*order = append(*order, key)
+		}
+		return b
+	}
+
+	for _, x := range s.Merges {
+		b := get(teams, &teamOrder, x.TeamName, x.TeamName)
+		b.merge = append(b.merge, x.Duration)
+	}
+	for _, x := range s.ReviewerMerges {
+		b := get(users, &userOrder, x.UserID, x.TeamName)
+		b.merge = append(b.merge, x.Duration)
+	}
+	for _, x := range s.Reviews {
+		b := get(teams, &teamOrder, x.TeamName, x.TeamName)
+		b.review = append(b.review, x.Duration)
+		b = get(users, &userOrder, x.UserID, x.TeamName)
+		b.review = append(b.review, x.Duration)
+	}
+
+	var res Turnaround
+	for _, name := range teamOrder {
+		b := teams[name]
+		res.Teams = append(res.Teams, TeamTurnaround{
+			TeamName:     name,
+			TimeToMerge:  NewPercentiles(b.merge),
+			TimeInReview: NewPercentiles(b.review),
+		})
+	}
+	for _, id := range userOrder {
+		b := users[id]
+		res.Reviewers = append(res.Reviewers, ReviewerTurnaround{
+			UserID:       id,
+			TeamName:     b.team,
+			TimeToMerge:  NewPercentiles(b.merge),
+			TimeInReview: NewPercentiles(b.review),
+		})
+	}
+	return res
+}
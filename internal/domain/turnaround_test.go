@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// minutes строит измерения 1..n минут в обратном порядке: NewPercentiles
+// должен сортировать сам.
+func minutes(n int) []time.Duration {
+	res := make([]time.Duration, n)
+	for i := range res {
+		res[i] = time.Duration(n-i) * time.Minute
+	}
+	return res
+}
+
+func TestNewPercentiles(t *testing.T) {
+	m := time.Minute
+	tests := []struct {
+		name    string
+		samples []time.Duration
+		want    Percentiles
+	}{
+		{"nil", nil, Percentiles{}},
+		{"empty", []time.Duration{}, Percentiles{}},
+		{"single sample", []time.Duration{7 * m}, Percentiles{Count: 1, P50: 7 * m, P90: 7 * m, P99: 7 * m}},
+		// ближайший ранг не усредняет: при чётном числе p50 — нижняя середина
+		{"two samples", minutes(2), Percentiles{Count: 2, P50: 1 * m, P90: 2 * m, P99: 2 * m}},
+		{"odd count", minutes(3), Percentiles{Count: 3, P50: 2 * m, P90: 3 * m, P99: 3 * m}},
+		{"even count", minutes(4), Percentiles{Count: 4, P50: 2 * m, P90: 4 * m, P99: 4 * m}},
+		// p·n целое — берётся ровно этот ранг, а не следующий
+		{"exact ranks", minutes(10), Percentiles{Count: 10, P50: 5 * m, P90: 9 * m, P99: 10 * m}},
+		{"just past p90", minutes(11), Percentiles{Count: 11, P50: 6 * m, P90: 10 * m, P99: 11 * m}},
+		{"exact p90 and p50", minutes(20), Percentiles{Count: 20, P50: 10 * m, P90: 18 * m, P99: 20 * m}},
+		{"exact p99", minutes(100), Percentiles{Count: 100, P50: 50 * m, P90: 90 * m, P99: 99 * m}},
+		{"duplicates", []time.Duration{3 * m, 1 * m, 3 * m, 1 * m}, Percentiles{Count: 4, P50: 1 * m, P90: 3 * m, P99: 3 * m}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPercentiles(tt.samples); got != tt.want {
+				t.Errorf("NewPercentiles = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTurnaroundAggregate(t *testing.T) {
+	h := time.Hour
+	tests := []struct {
+		name    string
+		samples TurnaroundSamples
+		want    Turnaround
+	}{
+		{"no samples", TurnaroundSamples{}, Turnaround{}},
+		{
+			name: "single merge",
+			samples: TurnaroundSamples{
+				Merges:         []DurationSample{{UserID: "a1", TeamName: "backend", Duration: 5 * h}},
+				ReviewerMerges: []DurationSample{{UserID: "r1", TeamName: "backend", Duration: 5 * h}},
+			},
+			want: Turnaround{
+				Teams: []TeamTurnaround{{
+					TeamName:    "backend",
+					TimeToMerge: Percentiles{Count: 1, P50: 5 * h, P90: 5 * h, P99: 5 * h},
+				}},
+				Reviewers: []ReviewerTurnaround{{
+					UserID: "r1", TeamName: "backend",
+					TimeToMerge: Percentiles{Count: 1, P50: 5 * h, P90: 5 * h, P99: 5 * h},
+				}},
+			},
+		},
+		{
+			// ревью без merge: команда и ревьювер есть, TimeToMerge пустой
+			name: "reviews only",
+			samples: TurnaroundSamples{
+				Reviews: []DurationSample{
+					{UserID: "r2", TeamName: "frontend", Duration: 3 * h},
+					{UserID: "r1", TeamName: "backend", Duration: 1 * h},
+					{UserID: "r2", TeamName: "frontend", Duration: 1 * h},
+				},
+			},
+			want: Turnaround{
+				Teams: []TeamTurnaround{
+					{TeamName: "frontend", TimeInReview: Percentiles{Count: 2, P50: 1 * h, P90: 3 * h, P99: 3 * h}},
+					{TeamName: "backend", TimeInReview: Percentiles{Count: 1, P50: 1 * h, P90: 1 * h, P99: 1 * h}},
+				},
+				Reviewers: []ReviewerTurnaround{
+					{UserID: "r2", TeamName: "frontend", TimeInReview: Percentiles{Count: 2, P50: 1 * h, P90: 3 * h, P99: 3 * h}},
+					{UserID: "r1", TeamName: "backend", TimeInReview: Percentiles{Count: 1, P50: 1 * h, P90: 1 * h, P99: 1 * h}},
+				},
+			},
+		},
+		{
+			name: "merges and reviews",
+			samples: TurnaroundSamples{
+				Merges: []DurationSample{
+					{UserID: "a1", TeamName: "backend", Duration: 10 * h},
+					{UserID: "a2", TeamName: "backend", Duration: 2 * h},
+					{UserID: "a3", TeamName: "backend", Duration: 6 * h},
+				},
+				ReviewerMerges: []DurationSample{
+					{UserID: "r1", TeamName: "backend", Duration: 10 * h},
+					{UserID: "r1", TeamName: "backend", Duration: 2 * h},
+					{UserID: "r2", TeamName: "backend", Duration: 6 * h},
+				},
+				Reviews: []DurationSample{
+					{UserID: "r1", TeamName: "backend", Duration: 4 * h},
+					{UserID: "r2", TeamName: "backend", Duration: 1 * h},
+				},
+			},
+			want: Turnaround{
+				Teams: []TeamTurnaround{{
+					TeamName:     "backend",
+					TimeToMerge:  Percentiles{Count: 3, P50: 6 * h, P90: 10 * h, P99: 10 * h},
+					TimeInReview: Percentiles{Count: 2, P50: 1 * h, P90: 4 * h, P99: 4 * h},
+				}},
+				Reviewers: []ReviewerTurnaround{
+					{
+						UserID: "r1", TeamName: "backend",
+						TimeToMerge:  Percentiles{Count: 2, P50: 2 * h, P90: 10 * h, P99: 10 * h},
+						TimeInReview: Percentiles{Count: 1, P50: 4 * h, P90: 4 * h, P99: 4 * h},
+					},
+					{
+						UserID: "r2", TeamName: "backend",
+						TimeToMerge:  Percentiles{Count: 1, P50: 6 * h, P90: 6 * h, P99: 6 * h},
+						TimeInReview: Percentiles{Count: 1, P50: 1 * h, P90: 1 * h, P99: 1 * h},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.samples.Aggregate(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Aggregate:\n got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
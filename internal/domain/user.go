@@ -1,8 +1,45 @@
 package domain
 
+import "time"
+
 type User struct {
 	ID       string
 	Username string
 	TeamName string
 	IsActive bool
 }
+
+// Absence — период [StartsAt, EndsAt), когда пользователь недоступен для ревью.
+type Absence struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+	// UID — UID события календаря, из которого импортировано отсутствие;
+	// пусто у заведённых вручную.
+	UID string
+}
+
+// Provider — внешняя система, присылающая события о PR.
+type Provider string
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+func (p Provider) Valid() bool {
+	switch p {
+	case ProviderGitHub, ProviderGitLab:
+		return true
+	}
+	return false
+}
+
+// ExternalLogin связывает логин во внешней системе с пользователем сервиса.
+type ExternalLogin struct {
+	Provider Provider
+	Login    string
+	UserID   string
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// errorMapping — HTTP-статус и код ErrorResponse для доменных ошибок.
+// Проверяется по порядку через errors.Is, поэтому обёрнутые ошибки
+// (fmt.Errorf("%w: ...")) распознаются так же.
+var errorMapping = []struct {
+	err    error
+	status int
+	code   ErrorResponseErrorCode
+}{
+	{domain.ErrTeamNotFound, http.StatusNotFound, NOTFOUND},
+	{domain.ErrUserNotFound, http.StatusNotFound, NOTFOUND},
+	{domain.ErrPRNotFound, http.StatusNotFound, NOTFOUND},
+	{domain.ErrAbsenceNotFound, http.StatusNotFound, NOTFOUND},
+
+	// по спецификации /team/add отвечает на дубликат 400
+	{domain.ErrTeamExists, http.StatusBadRequest, TEAMEXISTS},
+	{domain.ErrPRExists, http.StatusConflict, PREXISTS},
+	{domain.ErrPRMerged, http.StatusConflict, PRMERGED},
+	{domain.ErrAlreadyMerged, http.StatusConflict, PRMERGED},
+	{domain.ErrNotAssigned, http.StatusConflict, NOTASSIGNED},
+	{domain.ErrNoCandidate, http.StatusConflict, NOCANDIDATE},
+	{domain.ErrNotEnoughApprovals, http.StatusConflict, NOTENOUGHAPPROVALS},
+	{domain.ErrInvalidTransition, http.StatusConflict, INVALIDTRANSITION},
+	{domain.ErrPRNotOpen, http.StatusConflict, PRNOTOPEN},
+	{domain.ErrUserNotActive, http.StatusConflict, USERNOTACTIVE},
+
+	{domain.ErrUnknownStrategy, http.StatusBadRequest, INVALIDSETTINGS},
+	{domain.ErrInvalidSettings, http.StatusBadRequest, INVALIDSETTINGS},
+	{domain.ErrInvalidDecision, http.StatusBadRequest, INVALIDDECISION},
+	{domain.ErrInvalidAbsence, http.StatusBadRequest, INVALIDABSENCE},
+	{domain.ErrInvalidCalendar, http.StatusBadRequest, INVALIDFILE},
+	{domain.ErrInvalidCodeowners, http.StatusBadRequest, INVALIDFILE},
+	{domain.ErrUnknownProvider, http.StatusBadRequest, BADREQUEST},
+	{domain.ErrInvalidPeriod, http.StatusBadRequest, BADREQUEST},
+}
+
+// writeError отвечает ErrorResponse по доменной ошибке. Неизвестные
+// ошибки — 500 без подробностей наружу, текст уходит в лог.
+func writeError(w http.ResponseWriter, err error) {
+	var reqErr requestError
+	if errors.As(err, &reqErr) {
+		badRequest(w, reqErr.Error())
+		return
+	}
+
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			writeErrorResponse(w, m.status, m.code, err.Error())
+			return
+		}
+	}
+
+	log.Printf("internal error: %v", err)
+	writeErrorResponse(w, http.StatusInternalServerError, INTERNAL, "internal error")
+}
+
+// badRequest — ошибка разбора запроса (JSON, параметры).
+func badRequest(w http.ResponseWriter, message string) {
+	writeErrorResponse(w, http.StatusBadRequest, BADREQUEST, message)
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, code ErrorResponseErrorCode, message string) {
+	var resp ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// requestError — запрос не прошёл проверку обязательных полей.
+type requestError struct {
+	msg string
+}
+
+func (e requestError) Error() string { return e.msg }
+
+// required проверяет, что обязательные строковые поля запроса не пустые.
+// Принимает пары «имя, значение». Декодер strict-обёртки пропускает
+// отсутствующие поля как "", поэтому проверка нужна в самих обработчиках.
+func required(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return requestError{msg: fmt.Sprintf("%s is required", pairs[i])}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/service"
+)
+
+// newTestHandler — API поверх memory.Store с данными для каждой ошибки:
+//   - backend (u1, u2, u3): open — PR u1 с ревьюверами u2 и u3,
+//     draft, merged и closed — его PR в соответствующих статусах;
+//   - strict (s1, s2): min_approvals 1, strict-pr — PR s1 с ревьювером s2,
+//     заменить которого некем;
+//   - idle: неактивный idle1.
+func newTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+	ctx := context.Background()
+	s := memory.NewStore()
+	teamRepo := memory.NewTeamRepository(s)
+	userRepo := memory.NewUserRepository(s)
+	outbox := memory.NewOutboxRepository(s)
+
+	prs := service.NewPRService(memory.NewPRRepository(s), userRepo, teamRepo, s, outbox,
+		memory.NewAssignmentEventRepository(s), domain.DefaultReviewerCount, nil)
+	teams := service.NewTeamService(teamRepo, s, domain.DefaultReviewerCount)
+	users := service.NewUserService(userRepo, s)
+	admin := service.NewTeamAdminService(userRepo, prs, s, outbox)
+	sla := service.NewSLAService(memory.NewEscalationRepository(s), prs, outbox, s)
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	addTeam := func(name string, active bool, ids ...string) {
+		team := &domain.Team{Name: name}
+		for _, id := range ids {
+			team.Members = append(team.Members, domain.TeamMember{ID: id, Username: id, IsActive: active})
+		}
+		must(teams.CreateWithMembers(ctx, team))
+	}
+	createPR := func(id, author string, draft bool) {
+		_, err := prs.Create(ctx, service.NewPR{ID: id, Name: id, AuthorID: author, Draft: draft})
+		must(err)
+	}
+
+	addTeam("backend", true, "u1", "u2", "u3")
+	addTeam("strict", true, "s1", "s2")
+	addTeam("idle", false, "idle1")
+	_, err := teams.UpdateSettings(ctx, domain.TeamSettings{
+		TeamName: "strict", ReviewerStrategy: domain.StrategyRandom, ReviewerCount: 1, MinApprovals: 1,
+	})
+	must(err)
+
+	createPR("open", "u1", false)
+	createPR("draft", "u1", true)
+	createPR("merged", "u1", false)
+	_, err = prs.Merge(ctx, "merged")
+	must(err)
+	createPR("closed", "u1", false)
+	_, err = prs.Close(ctx, "closed")
+	must(err)
+	createPR("strict-pr", "s1", false)
+
+	return NewHandler(NewServer(teams, users, prs, admin, sla), chi.NewRouter())
+}
+
+// apiCase — запрос к API и ожидаемый ответ; code пуст у успешных ответов.
+type apiCase struct {
+	name        string
+	method      string
+	target      string
+	contentType string
+	body        string
+	status      int
+	code        ErrorResponseErrorCode
+}
+
+const (
+	jsonType = "application/json"
+	icsType  = "text/calendar"
+	textType = "text/plain"
+)
+
+func (c apiCase) request() *http.Request {
+	req := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+	if c.contentType != "" {
+		req.Header.Set("Content-Type", c.contentType)
+	}
+	return req
+}
+
+func (c apiCase) serve(h http.Handler) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, c.request())
+	return rec
+}
+
+// errorCases — по доменной ошибке на каждую ветку каждого эндпоинта
+// (данные — из newTestHandler).
+var errorCases = []apiCase{
+	// запрос не разбирается или не заполнен
+	{"bad JSON", http.MethodPost, "/team/add", jsonType, `{`, http.StatusBadRequest, BADREQUEST},
+	{"missing body field", http.MethodPost, "/team/add", jsonType, `{"members":[]}`, http.StatusBadRequest, BADREQUEST},
+	{"missing query param", http.MethodGet, "/team/get", "", "", http.StatusBadRequest, BADREQUEST},
+	{"bad date-time param", http.MethodGet, "/stats?from=yesterday", "", "", http.StatusBadRequest, BADREQUEST},
+
+	// команды
+	{"team add: exists", http.MethodPost, "/team/add", jsonType, `{"team_name":"backend","members":[]}`, http.StatusBadRequest, TEAMEXISTS},
+	{"team get: not found", http.MethodGet, "/team/get?team_name=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"settings get: not found", http.MethodGet, "/team/settings?team_name=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"settings: not found", http.MethodPost, "/team/settings", jsonType, `{"team_name":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"settings: unknown strategy", http.MethodPost, "/team/settings", jsonType, `{"team_name":"backend","reviewer_strategy":"coin"}`, http.StatusBadRequest, INVALIDSETTINGS},
+	{"settings: reviewer count", http.MethodPost, "/team/settings", jsonType, `{"team_name":"backend","reviewer_count":11}`, http.StatusBadRequest, INVALIDSETTINGS},
+	{"settings: negative SLA", http.MethodPost, "/team/settings", jsonType, `{"team_name":"backend","review_sla_hours":-1}`, http.StatusBadRequest, INVALIDSETTINGS},
+	{"codeowners get: not found", http.MethodGet, "/team/codeowners?team_name=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"codeowners: not found", http.MethodPost, "/team/codeowners?team_name=nope", textType, "* @u1\n", http.StatusNotFound, NOTFOUND},
+	{"codeowners: invalid owner", http.MethodPost, "/team/codeowners?team_name=backend", textType, "* u1\n", http.StatusBadRequest, INVALIDFILE},
+
+	// пользователи
+	{"set active: not found", http.MethodPost, "/users/setIsActive", jsonType, `{"user_id":"nope","is_active":false}`, http.StatusNotFound, NOTFOUND},
+	{"external login: provider", http.MethodPost, "/users/externalLogin", jsonType, `{"user_id":"u1","provider":"bitbucket","login":"a"}`, http.StatusBadRequest, BADREQUEST},
+	{"external login: not found", http.MethodPost, "/users/externalLogin", jsonType, `{"user_id":"nope","provider":"github","login":"a"}`, http.StatusNotFound, NOTFOUND},
+	{"absences: not found", http.MethodGet, "/users/absence?user_id=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"absence: not found", http.MethodPost, "/users/absence", jsonType, `{"user_id":"nope","starts_at":"2025-07-01T00:00:00Z","ends_at":"2025-07-02T00:00:00Z"}`, http.StatusNotFound, NOTFOUND},
+	{"absence: ends before start", http.MethodPost, "/users/absence", jsonType, `{"user_id":"u1","starts_at":"2025-07-02T00:00:00Z","ends_at":"2025-07-01T00:00:00Z"}`, http.StatusBadRequest, INVALIDABSENCE},
+	{"absence delete: not found", http.MethodPost, "/users/absence/delete", jsonType, `{"user_id":"u1","absence_id":999}`, http.StatusNotFound, NOTFOUND},
+	{"import: not found", http.MethodPost, "/users/absence/import?user_id=nope", icsType, ics("DTSTART;VALUE=DATE:20250701"), http.StatusNotFound, NOTFOUND},
+	{"import: malformed", http.MethodPost, "/users/absence/import?user_id=u1", icsType, "not a calendar", http.StatusBadRequest, INVALIDFILE},
+	{"import: recurring", http.MethodPost, "/users/absence/import?user_id=u1", icsType, ics("DTSTART;VALUE=DATE:20250701", "RRULE:FREQ=WEEKLY"), http.StatusBadRequest, INVALIDFILE},
+
+	// PR
+	{"create: exists", http.MethodPost, "/pullRequest/create", jsonType, `{"pull_request_id":"open","pull_request_name":"x","author_id":"u1"}`, http.StatusConflict, PREXISTS},
+	{"create: author not found", http.MethodPost, "/pullRequest/create", jsonType, `{"pull_request_id":"new","pull_request_name":"x","author_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"create: author inactive", http.MethodPost, "/pullRequest/create", jsonType, `{"pull_request_id":"new","pull_request_name":"x","author_id":"idle1"}`, http.StatusConflict, USERNOTACTIVE},
+	{"merge: not found", http.MethodPost, "/pullRequest/merge", jsonType, `{"pull_request_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"merge: not enough approvals", http.MethodPost, "/pullRequest/merge", jsonType, `{"pull_request_id":"strict-pr"}`, http.StatusConflict, NOTENOUGHAPPROVALS},
+	{"merge: closed", http.MethodPost, "/pullRequest/merge", jsonType, `{"pull_request_id":"closed"}`, http.StatusConflict, INVALIDTRANSITION},
+	{"close: not found", http.MethodPost, "/pullRequest/close", jsonType, `{"pull_request_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"close: merged", http.MethodPost, "/pullRequest/close", jsonType, `{"pull_request_id":"merged"}`, http.StatusConflict, INVALIDTRANSITION},
+	{"reopen: not found", http.MethodPost, "/pullRequest/reopen", jsonType, `{"pull_request_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"reopen: merged", http.MethodPost, "/pullRequest/reopen", jsonType, `{"pull_request_id":"merged"}`, http.StatusConflict, INVALIDTRANSITION},
+	{"ready: not found", http.MethodPost, "/pullRequest/ready", jsonType, `{"pull_request_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"ready: merged", http.MethodPost, "/pullRequest/ready", jsonType, `{"pull_request_id":"merged"}`, http.StatusConflict, INVALIDTRANSITION},
+	{"draft: not found", http.MethodPost, "/pullRequest/draft", jsonType, `{"pull_request_id":"nope"}`, http.StatusNotFound, NOTFOUND},
+	{"draft: merged", http.MethodPost, "/pullRequest/draft", jsonType, `{"pull_request_id":"merged"}`, http.StatusConflict, INVALIDTRANSITION},
+	{"escalations: not found", http.MethodGet, "/pullRequest/escalations?pull_request_id=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"history: not found", http.MethodGet, "/pullRequest/history?pull_request_id=nope", "", "", http.StatusNotFound, NOTFOUND},
+	{"review: not found", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"nope","reviewer_id":"u2","decision":"APPROVED"}`, http.StatusNotFound, NOTFOUND},
+	{"review: invalid decision", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"open","reviewer_id":"u2","decision":"MAYBE"}`, http.StatusBadRequest, INVALIDDECISION},
+	{"review: not assigned", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"open","reviewer_id":"u1","decision":"APPROVED"}`, http.StatusConflict, NOTASSIGNED},
+	{"review: merged", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"merged","reviewer_id":"u2","decision":"APPROVED"}`, http.StatusConflict, PRMERGED},
+	{"review: draft", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"draft","reviewer_id":"u2","decision":"APPROVED"}`, http.StatusConflict, PRNOTOPEN},
+	{"review: closed", http.MethodPost, "/pullRequest/review", jsonType, `{"pull_request_id":"closed","reviewer_id":"u2","decision":"APPROVED"}`, http.StatusConflict, PRNOTOPEN},
+	{"reassign: not found", http.MethodPost, "/pullRequest/reassign", jsonType, `{"pull_request_id":"nope","old_user_id":"u2"}`, http.StatusNotFound, NOTFOUND},
+	{"reassign: merged", http.MethodPost, "/pullRequest/reassign", jsonType, `{"pull_request_id":"merged","old_user_id":"u2"}`, http.StatusConflict, PRMERGED},
+	{"reassign: not assigned", http.MethodPost, "/pullRequest/reassign", jsonType, `{"pull_request_id":"open","old_user_id":"u1"}`, http.StatusConflict, NOTASSIGNED},
+	{"reassign: no candidate", http.MethodPost, "/pullRequest/reassign", jsonType, `{"pull_request_id":"strict-pr","old_user_id":"s2"}`, http.StatusConflict, NOCANDIDATE},
+
+	// статистика
+	{"stats: empty period", http.MethodGet, "/stats?from=2025-07-02T00:00:00Z&to=2025-07-01T00:00:00Z", "", "", http.StatusBadRequest, BADREQUEST},
+	{"turnaround: empty period", http.MethodGet, "/stats/turnaround?from=2025-07-02T00:00:00Z&to=2025-07-01T00:00:00Z", "", "", http.StatusBadRequest, BADREQUEST},
+	{"fairness: empty period", http.MethodGet, "/stats/fairness?from=2025-07-02T00:00:00Z&to=2025-07-01T00:00:00Z", "", "", http.StatusBadRequest, BADREQUEST},
+}
+
+func TestErrorResponses(t *testing.T) {
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tt.serve(newTestHandler(t))
+
+			var resp ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("%d %s: not an ErrorResponse: %v", rec.Code, rec.Body, err)
+			}
+			if rec.Code != tt.status || resp.Error.Code != tt.code {
+				t.Errorf("got %d %s, want %d %s (%s)", rec.Code, resp.Error.Code, tt.status, tt.code, resp.Error.Message)
+			}
+			if resp.Error.Message == "" {
+				t.Error("empty error message")
+			}
+		})
+	}
+}
+
+// Каждая доменная ошибка из errorMapping, в том числе обёрнутая,
+// даёт свой статус и код; неизвестная — 500 без подробностей.
+func TestWriteError(t *testing.T) {
+	for _, m := range errorMapping {
+		for _, err := range []error{m.err, fmt.Errorf("%w: details", m.err)} {
+			rec := httptest.NewRecorder()
+			writeError(rec, err)
+
+			var resp ErrorResponse
+			if e := json.Unmarshal(rec.Body.Bytes(), &resp); e != nil {
+				t.Fatal(e)
+			}
+			if rec.Code != m.status || resp.Error.Code != m.code || resp.Error.Message != err.Error() {
+				t.Errorf("%v: got %d %+v, want %d %s", err, rec.Code, resp.Error, m.status, m.code)
+			}
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	writeError(rec, errors.New("connection refused"))
+	var resp ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code != http.StatusInternalServerError || resp.Error.Code != INTERNAL || resp.Error.Message != "internal error" {
+		t.Errorf("unknown error: got %d %+v", rec.Code, resp.Error)
+	}
+}
+
+// ics — календарь из одного события с заданными свойствами.
+func ics(props ...string) string {
+	lines := append([]string{"BEGIN:VCALENDAR", "BEGIN:VEVENT", "UID:test"}, props...)
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -15,28 +16,142 @@ import (
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// Defines values for AssignmentEventAction.
+const (
+	Assigned   AssignmentEventAction = "assigned"
+	Unassigned AssignmentEventAction = "unassigned"
+)
+
+// Defines values for AssignmentEventReason.
+const (
+	AssignmentEventReasonAuto          AssignmentEventReason = "auto"
+	AssignmentEventReasonCodeowner     AssignmentEventReason = "codeowner"
+	AssignmentEventReasonDeactivation  AssignmentEventReason = "deactivation"
+	AssignmentEventReasonPrClosed      AssignmentEventReason = "pr_closed"
+	AssignmentEventReasonPrDraft       AssignmentEventReason = "pr_draft"
+	AssignmentEventReasonReassign      AssignmentEventReason = "reassign"
+	AssignmentEventReasonSlaEscalation AssignmentEventReason = "sla_escalation"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST         ErrorResponseErrorCode = "BAD_REQUEST"
+	INTERNAL           ErrorResponseErrorCode = "INTERNAL"
+	INVALIDABSENCE     ErrorResponseErrorCode = "INVALID_ABSENCE"
+	INVALIDDECISION    ErrorResponseErrorCode = "INVALID_DECISION"
+	INVALIDFILE        ErrorResponseErrorCode = "INVALID_FILE"
+	INVALIDSETTINGS    ErrorResponseErrorCode = "INVALID_SETTINGS"
+	INVALIDTRANSITION  ErrorResponseErrorCode = "INVALID_TRANSITION"
+	NOCANDIDATE        ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED        ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTENOUGHAPPROVALS ErrorResponseErrorCode = "NOT_ENOUGH_APPROVALS"
+	NOTFOUND           ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS           ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED           ErrorResponseErrorCode = "PR_MERGED"
+	PRNOTOPEN          ErrorResponseErrorCode = "PR_NOT_OPEN"
+	TEAMEXISTS         ErrorResponseErrorCode = "TEAM_EXISTS"
+	USERNOTACTIVE      ErrorResponseErrorCode = "USER_NOT_ACTIVE"
+)
+
+// Defines values for EscalationKind.
+const (
+	EscalationKindReassign EscalationKind = "reassign"
+	EscalationKindReminder EscalationKind = "reminder"
+)
+
+// Defines values for ExternalLoginProvider.
+const (
+	Github ExternalLoginProvider = "github"
+	Gitlab ExternalLoginProvider = "gitlab"
+)
+
+// Defines values for MemberFairnessFlag.
+const (
+	Over  MemberFairnessFlag = "over"
+	Under MemberFairnessFlag = "under"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewDecision.
+const (
+	APPROVED         ReviewDecision = "APPROVED"
+	CHANGESREQUESTED ReviewDecision = "CHANGES_REQUESTED"
+	COMMENTED        ReviewDecision = "COMMENTED"
+)
+
+// Defines values for TeamSettingsReviewerStrategy.
+const (
+	LeastLoaded TeamSettingsReviewerStrategy = "least_loaded"
+	Random      TeamSettingsReviewerStrategy = "random"
+	RoundRobin  TeamSettingsReviewerStrategy = "round_robin"
+	Weighted    TeamSettingsReviewerStrategy = "weighted"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	AbsenceId int64 `json:"absence_id"`
+
+	// EndsAt Конец отсутствия (не включительно)
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// Uid UID события календаря, из которого импортировано отсутствие
+	Uid    *string `json:"uid,omitempty"`
+	UserId string  `json:"user_id"`
+}
+
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	Action AssignmentEventAction `json:"action"`
+
+	// Actor Инициатор — X-Actor запроса, api, github, gitlab, sla или system
+	Actor     string    `json:"actor"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Detail Подробности, например кого заменил ревьювер
+	Detail  *string               `json:"detail,omitempty"`
+	EventId int64                 `json:"event_id"`
+	Reason  AssignmentEventReason `json:"reason"`
+	UserId  string                `json:"user_id"`
+}
+
+// AssignmentEventAction defines model for AssignmentEvent.Action.
+type AssignmentEventAction string
+
+// AssignmentEventReason defines model for AssignmentEvent.Reason.
+type AssignmentEventReason string
+
+// CodeownersRule defines model for CodeownersRule.
+type CodeownersRule struct {
+	Line    int      `json:"line"`
+	Owners  []string `json:"owners"`
+	Pattern string   `json:"pattern"`
+}
+
+// DurationPercentiles Перцентили длительности в секундах по count измерениям
+type DurationPercentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -48,16 +163,79 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// Escalation defines model for Escalation.
+type Escalation struct {
+	// AssignedAt Время назначения, с которого шёл отсчёт SLA
+	AssignedAt   time.Time      `json:"assigned_at"`
+	CreatedAt    time.Time      `json:"created_at"`
+	EscalationId int64          `json:"escalation_id"`
+	Kind         EscalationKind `json:"kind"`
+	Reason       string         `json:"reason"`
+
+	// ReplacedBy Новый ревьювер (для reassign)
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+	ReviewerId string  `json:"reviewer_id"`
+}
+
+// EscalationKind defines model for Escalation.Kind.
+type EscalationKind string
+
+// ExternalLogin defines model for ExternalLogin.
+type ExternalLogin struct {
+	Login    string                `json:"login"`
+	Provider ExternalLoginProvider `json:"provider"`
+	UserId   string                `json:"user_id"`
+}
+
+// ExternalLoginProvider defines model for ExternalLogin.Provider.
+type ExternalLoginProvider string
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	From  time.Time      `json:"from"`
+	Teams []TeamFairness `json:"teams"`
+	To    time.Time      `json:"to"`
+	Weeks int            `json:"weeks"`
+}
+
+// MemberFairness defines model for MemberFairness.
+type MemberFairness struct {
+	Assignments int `json:"assignments"`
+
+	// Deviation Отклонение от среднего по команде за окно
+	Deviation float64 `json:"deviation"`
+
+	// Flag Перекос держится не меньше половины недель с назначениями в команде
+	Flag *MemberFairnessFlag `json:"flag,omitempty"`
+
+	// OverWeeks Недели, где назначений больше среднего по команде более чем на 25%
+	OverWeeks int `json:"over_weeks"`
+
+	// UnderWeeks Недели, где назначений меньше среднего по команде более чем на 25%
+	UnderWeeks int    `json:"under_weeks"`
+	UserId     string `json:"user_id"`
+}
+
+// MemberFairnessFlag Перекос держится не меньше половины недель с назначениями в команде
+type MemberFairnessFlag string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..reviewer_count команды автора)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// Files Изменённые файлы, переданные при создании
+	Files           *[]string  `json:"files,omitempty"`
+	MergedAt        *time.Time `json:"mergedAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// Reviews Решения назначенных ревьюверов
+	Reviews *[]Review         `json:"reviews,omitempty"`
+	Status  PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -74,12 +252,68 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Review defines model for Review.
+type Review struct {
+	DecidedAt *time.Time      `json:"decided_at"`
+	Decision  *ReviewDecision `json:"decision,omitempty"`
+
+	// FallbackTeam Команда ревьювера, если он назначен по fallback не из команды автора
+	FallbackTeam *string `json:"fallback_team,omitempty"`
+	ReviewerId   string  `json:"reviewer_id"`
+}
+
+// ReviewDecision defines model for ReviewDecision.
+type ReviewDecision string
+
+// ReviewerTurnaround defines model for ReviewerTurnaround.
+type ReviewerTurnaround struct {
+	TeamName string `json:"team_name"`
+
+	// TimeInReview Перцентили длительности в секундах по count измерениям
+	TimeInReview DurationPercentiles `json:"time_in_review"`
+
+	// TimeToMerge Перцентили длительности в секундах по count измерениям
+	TimeToMerge DurationPercentiles `json:"time_to_merge"`
+	UserId      string              `json:"user_id"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// PrStatus Число PR по статусам
+	PrStatus map[string]int `json:"prStatus"`
+
+	// ReviewerAssignments Число назначений по user_id
+	ReviewerAssignments map[string]int `json:"reviewerAssignments"`
+	Teams               []TeamStats    `json:"teams"`
+	Users               []UserStats    `json:"users"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamCodeowners defines model for TeamCodeowners.
+type TeamCodeowners struct {
+	// Content Файл в том виде, в котором он был загружен
+	Content   string           `json:"content"`
+	Rules     []CodeownersRule `json:"rules"`
+	TeamName  string           `json:"team_name"`
+	UpdatedAt *time.Time       `json:"updated_at"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	Assignments int `json:"assignments"`
+
+	// Gini 0 — поровну, ближе к 1 — назначения у одного участника
+	Gini     float64          `json:"gini"`
+	Mean     float64          `json:"mean"`
+	Members  []MemberFairness `json:"members"`
+	TeamName string           `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -87,6 +321,59 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// EscalationHours Через сколько часов без решения заменить ревьювера другим (0 — выключено),
+	// больше review_sla_hours
+	EscalationHours *int `json:"escalation_hours,omitempty"`
+
+	// FallbackTeams Команды, из которых по порядку добираются ревьюверы, если в своей команде
+	// кандидатов не хватило; "*" — любой активный пользователь
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MinApprovals Минимум апрувов для merge (0 — без ограничения), не больше reviewer_count. Если на PR назначено меньше ревьюверов, нужны апрувы от всех назначенных; PR без ревьюверов не вливается
+	MinApprovals *int `json:"min_approvals,omitempty"`
+
+	// ReviewSlaHours Через сколько часов без решения напомнить ревьюверу (0 — выключено)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewerCount Сколько ревьюверов назначать на PR (по умолчанию — reviewers.default_count из конфигурации сервиса, 2)
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов при создании PR и переназначении
+	ReviewerStrategy *TeamSettingsReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName         string                        `json:"team_name"`
+}
+
+// TeamSettingsReviewerStrategy Стратегия выбора ревьюверов при создании PR и переназначении
+type TeamSettingsReviewerStrategy string
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	Assignments       int    `json:"assignments"`
+	AuthoredPrs       int    `json:"authored_prs"`
+	MergedAssignments int    `json:"merged_assignments"`
+	OpenAssignments   int    `json:"open_assignments"`
+	TeamName          string `json:"team_name"`
+}
+
+// TeamTurnaround defines model for TeamTurnaround.
+type TeamTurnaround struct {
+	TeamName string `json:"team_name"`
+
+	// TimeInReview Перцентили длительности в секундах по count измерениям
+	TimeInReview DurationPercentiles `json:"time_in_review"`
+
+	// TimeToMerge Перцентили длительности в секундах по count измерениям
+	TimeToMerge DurationPercentiles `json:"time_to_merge"`
+}
+
+// Turnaround defines model for Turnaround.
+type Turnaround struct {
+	Reviewers []ReviewerTurnaround `json:"reviewers"`
+	Teams     []TeamTurnaround     `json:"teams"`
+}
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -95,17 +382,74 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserAbsences defines model for UserAbsences.
+type UserAbsences struct {
+	Absences []Absence `json:"absences"`
+	UserId   string    `json:"user_id"`
+}
+
+// UserStats defines model for UserStats.
+type UserStats struct {
+	// Assignments Назначения ревьювером на PR в любом статусе
+	Assignments       int    `json:"assignments"`
+	AuthoredPrs       int    `json:"authored_prs"`
+	MergedAssignments int    `json:"merged_assignments"`
+	OpenAssignments   int    `json:"open_assignments"`
+	TeamName          string `json:"team_name"`
+	UserId            string `json:"user_id"`
+}
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// StatsTeamQuery defines model for StatsTeamQuery.
+type StatsTeamQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// Draft Черновик — ревьюверы назначаются после /pullRequest/ready
+	Draft *bool `json:"draft,omitempty"`
+
+	// Files Изменённые файлы — по CODEOWNERS команды автора на каждый назначается владелец
+	Files           *[]string `json:"files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// PostPullRequestDraftJSONBody defines parameters for PostPullRequestDraft.
+type PostPullRequestDraftJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// GetPullRequestEscalationsParams defines parameters for GetPullRequestEscalations.
+type GetPullRequestEscalationsParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -113,18 +457,123 @@ type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Decision      ReviewDecision `json:"decision"`
+	PullRequestId string         `json:"pull_request_id"`
+	ReviewerId    string         `json:"reviewer_id"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// Team Только эта команда
+	Team *StatsTeamQuery `form:"team,omitempty" json:"team,omitempty"`
+
+	// From Начало периода (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// Team Только эта команда
+	Team *StatsTeamQuery `form:"team,omitempty" json:"team,omitempty"`
+
+	// From Начало периода (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsTurnaroundParams defines parameters for GetStatsTurnaround.
+type GetStatsTurnaroundParams struct {
+	// Team Только эта команда
+	Team *StatsTeamQuery `form:"team,omitempty" json:"team,omitempty"`
+
+	// From Начало периода (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetTeamCodeownersParams defines parameters for GetTeamCodeowners.
+type GetTeamCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamCodeownersTextBody defines parameters for PostTeamCodeowners.
+type PostTeamCodeownersTextBody = string
+
+// PostTeamCodeownersParams defines parameters for PostTeamCodeowners.
+type PostTeamCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
+type PostTeamDeactivateJSONBody struct {
+	Team string `json:"team"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetUsersAbsenceParams defines parameters for GetUsersAbsence.
+type GetUsersAbsenceParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersAbsenceJSONBody defines parameters for PostUsersAbsence.
+type PostUsersAbsenceJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostUsersAbsenceDeleteJSONBody defines parameters for PostUsersAbsenceDelete.
+type PostUsersAbsenceDeleteJSONBody struct {
+	AbsenceId int64  `json:"absence_id"`
+	UserId    string `json:"user_id"`
+}
+
+// PostUsersAbsenceImportParams defines parameters for PostUsersAbsenceImport.
+type PostUsersAbsenceImportParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
@@ -137,38 +586,131 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
+// PostPullRequestDraftJSONRequestBody defines body for PostPullRequestDraft for application/json ContentType.
+type PostPullRequestDraftJSONRequestBody PostPullRequestDraftJSONBody
+
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamCodeownersTextRequestBody defines body for PostTeamCodeowners for text/plain ContentType.
+type PostTeamCodeownersTextRequestBody = PostTeamCodeownersTextBody
+
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody = TeamSettings
+
+// PostUsersAbsenceJSONRequestBody defines body for PostUsersAbsence for application/json ContentType.
+type PostUsersAbsenceJSONRequestBody PostUsersAbsenceJSONBody
+
+// PostUsersAbsenceDeleteJSONRequestBody defines body for PostUsersAbsenceDelete for application/json ContentType.
+type PostUsersAbsenceDeleteJSONRequestBody PostUsersAbsenceDeleteJSONBody
+
+// PostUsersExternalLoginJSONRequestBody defines body for PostUsersExternalLogin for application/json ContentType.
+type PostUsersExternalLoginJSONRequestBody = ExternalLogin
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Закрыть PR без merge (ревьюверы снимаются, идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до reviewer_count ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Вернуть открытый PR в черновик (ревьюверы снимаются)
+	// (POST /pullRequest/draft)
+	PostPullRequestDraft(w http.ResponseWriter, r *http.Request)
+	// Напоминания и переназначения ревьюверов по SLA
+	// (GET /pullRequest/escalations)
+	GetPullRequestEscalations(w http.ResponseWriter, r *http.Request, params GetPullRequestEscalationsParams)
+	// Журнал назначений и снятий ревьюверов по PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из команды автора PR (с её цепочкой fallback)
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Переоткрыть закрытый PR (ревьюверы назначаются заново)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(w http.ResponseWriter, r *http.Request)
+	// Записать решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(w http.ResponseWriter, r *http.Request)
+	// Статистика назначений и PR с разбивкой по командам и пользователям
+	// (GET /stats)
+	GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams)
+	// Равномерность назначений ревьюверов внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// Скорость ревью — перцентили времени до merge и времени ревью
+	// (GET /stats/turnaround)
+	GetStatsTurnaround(w http.ResponseWriter, r *http.Request, params GetStatsTurnaroundParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Получить файл владения команды (CODEOWNERS)
+	// (GET /team/codeowners)
+	GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams)
+	// Загрузить файл владения команды в синтаксисе GitHub CODEOWNERS
+	// (POST /team/codeowners)
+	PostTeamCodeowners(w http.ResponseWriter, r *http.Request, params PostTeamCodeownersParams)
+	// Деактивировать всех участников команды и переназначить их открытые PR
+	// (POST /team/deactivate)
+	PostTeamDeactivate(w http.ResponseWriter, r *http.Request)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Получить настройки команды
+	// (GET /team/settings)
+	GetTeamSettings(w http.ResponseWriter, r *http.Request, params GetTeamSettingsParams)
+	// Обновить настройки команды (не переданные поля не меняются)
+	// (POST /team/settings)
+	PostTeamSettings(w http.ResponseWriter, r *http.Request)
+	// Получить периоды отсутствия пользователя
+	// (GET /users/absence)
+	GetUsersAbsence(w http.ResponseWriter, r *http.Request, params GetUsersAbsenceParams)
+	// Добавить период отсутствия (пользователь не назначается ревьювером)
+	// (POST /users/absence)
+	PostUsersAbsence(w http.ResponseWriter, r *http.Request)
+	// Удалить период отсутствия
+	// (POST /users/absence/delete)
+	PostUsersAbsenceDelete(w http.ResponseWriter, r *http.Request)
+	// Импортировать периоды отсутствия из iCalendar-файла (.ics)
+	// (POST /users/absence/import)
+	PostUsersAbsenceImport(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceImportParams)
+	// Связать логин во внешней системе (GitHub, GitLab) с пользователем
+	// (POST /users/externalLogin)
+	PostUsersExternalLogin(w http.ResponseWriter, r *http.Request)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
@@ -181,36 +723,156 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Закрыть PR без merge (ревьюверы снимаются, идемпотентная операция)
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до reviewer_count ревьюверов из команды автора
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Вернуть открытый PR в черновик (ревьюверы снимаются)
+// (POST /pullRequest/draft)
+func (_ Unimplemented) PostPullRequestDraft(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Напоминания и переназначения ревьюверов по SLA
+// (GET /pullRequest/escalations)
+func (_ Unimplemented) GetPullRequestEscalations(w http.ResponseWriter, r *http.Request, params GetPullRequestEscalationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал назначений и снятий ревьюверов по PR
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
 func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Переназначить конкретного ревьювера на другого из его команды
+// Перевести черновик в OPEN и назначить ревьюверов
+// (POST /pullRequest/ready)
+func (_ Unimplemented) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из команды автора PR (с её цепочкой fallback)
 // (POST /pullRequest/reassign)
 func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Переоткрыть закрытый PR (ревьюверы назначаются заново)
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Записать решение ревьювера по PR
+// (POST /pullRequest/review)
+func (_ Unimplemented) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика назначений и PR с разбивкой по командам и пользователям
+// (GET /stats)
+func (_ Unimplemented) GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Равномерность назначений ревьюверов внутри команд
+// (GET /stats/fairness)
+func (_ Unimplemented) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Скорость ревью — перцентили времени до merge и времени ревью
+// (GET /stats/turnaround)
+func (_ Unimplemented) GetStatsTurnaround(w http.ResponseWriter, r *http.Request, params GetStatsTurnaroundParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать команду с участниками (создаёт/обновляет пользователей)
 // (POST /team/add)
 func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить файл владения команды (CODEOWNERS)
+// (GET /team/codeowners)
+func (_ Unimplemented) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить файл владения команды в синтаксисе GitHub CODEOWNERS
+// (POST /team/codeowners)
+func (_ Unimplemented) PostTeamCodeowners(w http.ResponseWriter, r *http.Request, params PostTeamCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Деактивировать всех участников команды и переназначить их открытые PR
+// (POST /team/deactivate)
+func (_ Unimplemented) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить команду с участниками
 // (GET /team/get)
 func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить настройки команды
+// (GET /team/settings)
+func (_ Unimplemented) GetTeamSettings(w http.ResponseWriter, r *http.Request, params GetTeamSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить настройки команды (не переданные поля не меняются)
+// (POST /team/settings)
+func (_ Unimplemented) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить периоды отсутствия пользователя
+// (GET /users/absence)
+func (_ Unimplemented) GetUsersAbsence(w http.ResponseWriter, r *http.Request, params GetUsersAbsenceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить период отсутствия (пользователь не назначается ревьювером)
+// (POST /users/absence)
+func (_ Unimplemented) PostUsersAbsence(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить период отсутствия
+// (POST /users/absence/delete)
+func (_ Unimplemented) PostUsersAbsenceDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импортировать периоды отсутствия из iCalendar-файла (.ics)
+// (POST /users/absence/import)
+func (_ Unimplemented) PostUsersAbsenceImport(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Связать логин во внешней системе (GitHub, GitLab) с пользователем
+// (POST /users/externalLogin)
+func (_ Unimplemented) PostUsersExternalLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить PR'ы, где пользователь назначен ревьювером
 // (GET /users/getReview)
 func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
@@ -232,11 +894,11 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostPullRequestClose(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -246,11 +908,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostPullRequestCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -260,11 +922,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestDraft operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestDraft(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostPullRequestDraft(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -274,11 +936,31 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// GetPullRequestEscalations operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestEscalations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestEscalationsParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.GetPullRequestEscalations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -288,31 +970,31 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestHistoryParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetPullRequestHistory(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -322,31 +1004,39 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ------------- Required query parameter "user_id" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReady(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostPullRequestReassign(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -356,11 +1046,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostPullRequestReopen(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -370,410 +1060,2219 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReview(w, r)
+	}))
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
 
-type RequiredParamError struct {
-	ParamName string
-}
+	var err error
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "team" -------------
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "from" -------------
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "to" -------------
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r, params)
+	}))
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	handler.ServeHTTP(w, r)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	var err error
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Optional query parameter "team" -------------
 
-	if r == nil {
-		r = chi.NewRouter()
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+// GetStatsTurnaround operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTurnaround(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	var err error
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTurnaroundParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "team" -------------
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "team", r.URL.Query(), &params.Team)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "from" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+	// ------------- Optional query parameter "to" -------------
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTurnaround(w, r, params)
+	}))
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// GetTeamCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCodeowners(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
+// PostTeamCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+// PostTeamDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSettings(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+// PostTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+// GetUsersAbsence operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersAbsence(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAbsenceParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersAbsence(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+// PostUsersAbsence operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsence(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsence(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
+// PostUsersAbsenceDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsenceDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsenceDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+// PostUsersAbsenceImport operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsenceImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsenceImportParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsenceImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PostUsersExternalLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersExternalLogin(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersExternalLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/draft", wrapper.PostPullRequestDraft)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/escalations", wrapper.GetPullRequestEscalations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats", wrapper.GetStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/turnaround", wrapper.GetStatsTurnaround)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/codeowners", wrapper.GetTeamCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/codeowners", wrapper.PostTeamCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/settings", wrapper.GetTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/settings", wrapper.PostTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/absence", wrapper.GetUsersAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absence", wrapper.PostUsersAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absence/delete", wrapper.PostUsersAbsenceDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absence/import", wrapper.PostUsersAbsenceImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/externalLogin", wrapper.PostUsersExternalLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+
+	return r
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDraftRequestObject struct {
+	Body *PostPullRequestDraftJSONRequestBody
+}
+
+type PostPullRequestDraftResponseObject interface {
+	VisitPostPullRequestDraftResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestDraft200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestDraft200JSONResponse) VisitPostPullRequestDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDraft404JSONResponse ErrorResponse
+
+func (response PostPullRequestDraft404JSONResponse) VisitPostPullRequestDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDraft409JSONResponse ErrorResponse
+
+func (response PostPullRequestDraft409JSONResponse) VisitPostPullRequestDraftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestEscalationsRequestObject struct {
+	Params GetPullRequestEscalationsParams
+}
+
+type GetPullRequestEscalationsResponseObject interface {
+	VisitGetPullRequestEscalationsResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestEscalations200JSONResponse struct {
+	Escalations   []Escalation `json:"escalations"`
+	PullRequestId string       `json:"pull_request_id"`
+}
+
+func (response GetPullRequestEscalations200JSONResponse) VisitGetPullRequestEscalationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestEscalations404JSONResponse ErrorResponse
+
+func (response GetPullRequestEscalations404JSONResponse) VisitGetPullRequestEscalationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse struct {
+	Events        []AssignmentEvent `json:"events"`
+	PullRequestId string            `json:"pull_request_id"`
+}
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Body *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewRequestObject struct {
+	Body *PostPullRequestReviewJSONRequestBody
+}
+
+type PostPullRequestReviewResponseObject interface {
+	VisitPostPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReview200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReview200JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestReview400JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestReview404JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestReview409JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats400JSONResponse ErrorResponse
+
+func (response GetStats400JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairnessRequestObject struct {
+	Params GetStatsFairnessParams
+}
+
+type GetStatsFairnessResponseObject interface {
+	VisitGetStatsFairnessResponse(w http.ResponseWriter) error
+}
+
+type GetStatsFairness200JSONResponse FairnessReport
+
+func (response GetStatsFairness200JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness400JSONResponse ErrorResponse
+
+func (response GetStatsFairness400JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTurnaroundRequestObject struct {
+	Params GetStatsTurnaroundParams
+}
+
+type GetStatsTurnaroundResponseObject interface {
+	VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTurnaround200JSONResponse Turnaround
+
+func (response GetStatsTurnaround200JSONResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTurnaround400JSONResponse ErrorResponse
+
+func (response GetStatsTurnaround400JSONResponse) VisitGetStatsTurnaroundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeownersRequestObject struct {
+	Params GetTeamCodeownersParams
+}
+
+type GetTeamCodeownersResponseObject interface {
+	VisitGetTeamCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetTeamCodeowners200JSONResponse TeamCodeowners
+
+func (response GetTeamCodeowners200JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeowners404JSONResponse ErrorResponse
+
+func (response GetTeamCodeowners404JSONResponse) VisitGetTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeownersRequestObject struct {
+	Params PostTeamCodeownersParams
+	Body   *PostTeamCodeownersTextRequestBody
+}
+
+type PostTeamCodeownersResponseObject interface {
+	VisitPostTeamCodeownersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamCodeowners200JSONResponse TeamCodeowners
+
+func (response PostTeamCodeowners200JSONResponse) VisitPostTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeowners400JSONResponse ErrorResponse
+
+func (response PostTeamCodeowners400JSONResponse) VisitPostTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeowners404JSONResponse ErrorResponse
+
+func (response PostTeamCodeowners404JSONResponse) VisitPostTeamCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateRequestObject struct {
+	Body *PostTeamDeactivateJSONRequestBody
+}
+
+type PostTeamDeactivateResponseObject interface {
+	VisitPostTeamDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDeactivate200JSONResponse struct {
+	Status string `json:"status"`
+}
+
+func (response PostTeamDeactivate200JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivate400JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate400JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet400JSONResponse ErrorResponse
+
+func (response GetTeamGet400JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsRequestObject struct {
+	Params GetTeamSettingsParams
+}
+
+type GetTeamSettingsResponseObject interface {
+	VisitGetTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSettings200JSONResponse TeamSettings
+
+func (response GetTeamSettings200JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings404JSONResponse ErrorResponse
+
+func (response GetTeamSettings404JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRequestObject struct {
+	Body *PostTeamSettingsJSONRequestBody
+}
+
+type PostTeamSettingsResponseObject interface {
+	VisitPostTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSettings200JSONResponse TeamSettings
+
+func (response PostTeamSettings200JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings400JSONResponse ErrorResponse
+
+func (response PostTeamSettings400JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings404JSONResponse ErrorResponse
+
+func (response PostTeamSettings404JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsenceRequestObject struct {
+	Params GetUsersAbsenceParams
+}
+
+type GetUsersAbsenceResponseObject interface {
+	VisitGetUsersAbsenceResponse(w http.ResponseWriter) error
+}
+
+type GetUsersAbsence200JSONResponse UserAbsences
+
+func (response GetUsersAbsence200JSONResponse) VisitGetUsersAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsence404JSONResponse ErrorResponse
+
+func (response GetUsersAbsence404JSONResponse) VisitGetUsersAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsenceRequestObject struct {
+	Body *PostUsersAbsenceJSONRequestBody
+}
+
+type PostUsersAbsenceResponseObject interface {
+	VisitPostUsersAbsenceResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsence201JSONResponse struct {
+	Absence *Absence `json:"absence,omitempty"`
+}
+
+func (response PostUsersAbsence201JSONResponse) VisitPostUsersAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsence400JSONResponse ErrorResponse
+
+func (response PostUsersAbsence400JSONResponse) VisitPostUsersAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsence404JSONResponse ErrorResponse
+
+func (response PostUsersAbsence404JSONResponse) VisitPostUsersAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsenceDeleteRequestObject struct {
+	Body *PostUsersAbsenceDeleteJSONRequestBody
+}
+
+type PostUsersAbsenceDeleteResponseObject interface {
+	VisitPostUsersAbsenceDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsenceDelete204Response struct {
+}
+
+func (response PostUsersAbsenceDelete204Response) VisitPostUsersAbsenceDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostUsersAbsenceDelete404JSONResponse ErrorResponse
+
+func (response PostUsersAbsenceDelete404JSONResponse) VisitPostUsersAbsenceDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsenceImportRequestObject struct {
+	Params PostUsersAbsenceImportParams
+	Body   io.Reader
+}
+
+type PostUsersAbsenceImportResponseObject interface {
+	VisitPostUsersAbsenceImportResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsenceImport201JSONResponse UserAbsences
+
+func (response PostUsersAbsenceImport201JSONResponse) VisitPostUsersAbsenceImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsenceImport400JSONResponse ErrorResponse
+
+func (response PostUsersAbsenceImport400JSONResponse) VisitPostUsersAbsenceImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsenceImport404JSONResponse ErrorResponse
+
+func (response PostUsersAbsenceImport404JSONResponse) VisitPostUsersAbsenceImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExternalLoginRequestObject struct {
+	Body *PostUsersExternalLoginJSONRequestBody
+}
+
+type PostUsersExternalLoginResponseObject interface {
+	VisitPostUsersExternalLoginResponse(w http.ResponseWriter) error
+}
+
+type PostUsersExternalLogin200JSONResponse ExternalLogin
+
+func (response PostUsersExternalLogin200JSONResponse) VisitPostUsersExternalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExternalLogin400JSONResponse ErrorResponse
+
+func (response PostUsersExternalLogin400JSONResponse) VisitPostUsersExternalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExternalLogin404JSONResponse ErrorResponse
+
+func (response PostUsersExternalLogin404JSONResponse) VisitPostUsersExternalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Закрыть PR без merge (ревьюверы снимаются, идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить до reviewer_count ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Вернуть открытый PR в черновик (ревьюверы снимаются)
+	// (POST /pullRequest/draft)
+	PostPullRequestDraft(ctx context.Context, request PostPullRequestDraftRequestObject) (PostPullRequestDraftResponseObject, error)
+	// Напоминания и переназначения ревьюверов по SLA
+	// (GET /pullRequest/escalations)
+	GetPullRequestEscalations(ctx context.Context, request GetPullRequestEscalationsRequestObject) (GetPullRequestEscalationsResponseObject, error)
+	// Журнал назначений и снятий ревьюверов по PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из команды автора PR (с её цепочкой fallback)
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Переоткрыть закрытый PR (ревьюверы назначаются заново)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Записать решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx context.Context, request PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error)
+	// Статистика назначений и PR с разбивкой по командам и пользователям
+	// (GET /stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Равномерность назначений ревьюверов внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx context.Context, request GetStatsFairnessRequestObject) (GetStatsFairnessResponseObject, error)
+	// Скорость ревью — перцентили времени до merge и времени ревью
+	// (GET /stats/turnaround)
+	GetStatsTurnaround(ctx context.Context, request GetStatsTurnaroundRequestObject) (GetStatsTurnaroundResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить файл владения команды (CODEOWNERS)
+	// (GET /team/codeowners)
+	GetTeamCodeowners(ctx context.Context, request GetTeamCodeownersRequestObject) (GetTeamCodeownersResponseObject, error)
+	// Загрузить файл владения команды в синтаксисе GitHub CODEOWNERS
+	// (POST /team/codeowners)
+	PostTeamCodeowners(ctx context.Context, request PostTeamCodeownersRequestObject) (PostTeamCodeownersResponseObject, error)
+	// Деактивировать всех участников команды и переназначить их открытые PR
+	// (POST /team/deactivate)
+	PostTeamDeactivate(ctx context.Context, request PostTeamDeactivateRequestObject) (PostTeamDeactivateResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Получить настройки команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx context.Context, request GetTeamSettingsRequestObject) (GetTeamSettingsResponseObject, error)
+	// Обновить настройки команды (не переданные поля не меняются)
+	// (POST /team/settings)
+	PostTeamSettings(ctx context.Context, request PostTeamSettingsRequestObject) (PostTeamSettingsResponseObject, error)
+	// Получить периоды отсутствия пользователя
+	// (GET /users/absence)
+	GetUsersAbsence(ctx context.Context, request GetUsersAbsenceRequestObject) (GetUsersAbsenceResponseObject, error)
+	// Добавить период отсутствия (пользователь не назначается ревьювером)
+	// (POST /users/absence)
+	PostUsersAbsence(ctx context.Context, request PostUsersAbsenceRequestObject) (PostUsersAbsenceResponseObject, error)
+	// Удалить период отсутствия
+	// (POST /users/absence/delete)
+	PostUsersAbsenceDelete(ctx context.Context, request PostUsersAbsenceDeleteRequestObject) (PostUsersAbsenceDeleteResponseObject, error)
+	// Импортировать периоды отсутствия из iCalendar-файла (.ics)
+	// (POST /users/absence/import)
+	PostUsersAbsenceImport(ctx context.Context, request PostUsersAbsenceImportRequestObject) (PostUsersAbsenceImportResponseObject, error)
+	// Связать логин во внешней системе (GitHub, GitLab) с пользователем
+	// (POST /users/externalLogin)
+	PostUsersExternalLogin(ctx context.Context, request PostUsersExternalLoginRequestObject) (PostUsersExternalLoginResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestDraft operation middleware
+func (sh *strictHandler) PostPullRequestDraft(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestDraftRequestObject
+
+	var body PostPullRequestDraftJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestDraft(ctx, request.(PostPullRequestDraftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestDraft")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestDraftResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestDraftResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestEscalations operation middleware
+func (sh *strictHandler) GetPullRequestEscalations(w http.ResponseWriter, r *http.Request, params GetPullRequestEscalationsParams) {
+	var request GetPullRequestEscalationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestEscalations(ctx, request.(GetPullRequestEscalationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestEscalations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestEscalationsResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestEscalationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx, request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReadyRequestObject
+
+	var body PostPullRequestReadyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx, request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReview operation middleware
+func (sh *strictHandler) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReviewRequestObject
+
+	var body PostPullRequestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReview(ctx, request.(PostPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams) {
+	var request GetStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsFairness operation middleware
+func (sh *strictHandler) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	var request GetStatsFairnessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsFairness(ctx, request.(GetStatsFairnessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsFairness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsFairnessResponseObject); ok {
+		if err := validResponse.VisitGetStatsFairnessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsTurnaround operation middleware
+func (sh *strictHandler) GetStatsTurnaround(w http.ResponseWriter, r *http.Request, params GetStatsTurnaroundParams) {
+	var request GetStatsTurnaroundRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTurnaround(ctx, request.(GetStatsTurnaroundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTurnaround")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsTurnaroundResponseObject); ok {
+		if err := validResponse.VisitGetStatsTurnaroundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+// GetTeamCodeowners operation middleware
+func (sh *strictHandler) GetTeamCodeowners(w http.ResponseWriter, r *http.Request, params GetTeamCodeownersParams) {
+	var request GetTeamCodeownersRequestObject
 
-type GetTeamGet200JSONResponse Team
+	request.Params = params
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamCodeowners(ctx, request.(GetTeamCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamCodeowners")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetTeamCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostTeamCodeowners operation middleware
+func (sh *strictHandler) PostTeamCodeowners(w http.ResponseWriter, r *http.Request, params PostTeamCodeownersParams) {
+	var request PostTeamCodeownersRequestObject
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := PostTeamCodeownersTextRequestBody(data)
+	request.Body = &body
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamCodeowners(ctx, request.(PostTeamCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamCodeowners")
+	}
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamCodeownersResponseObject); ok {
+		if err := validResponse.VisitPostTeamCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamDeactivate operation middleware
+func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamDeactivateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostTeamDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDeactivate(ctx, request.(PostTeamDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDeactivate")
+	}
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	request.Params = params
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetTeamSettings operation middleware
+func (sh *strictHandler) GetTeamSettings(w http.ResponseWriter, r *http.Request, params GetTeamSettingsParams) {
+	var request GetTeamSettingsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSettings(ctx, request.(GetTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSettings")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitGetTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+// PostTeamSettings operation middleware
+func (sh *strictHandler) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamSettingsRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostTeamSettingsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -781,18 +3280,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamSettings(ctx, request.(PostTeamSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitPostTeamSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -800,30 +3299,25 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// GetUsersAbsence operation middleware
+func (sh *strictHandler) GetUsersAbsence(w http.ResponseWriter, r *http.Request, params GetUsersAbsenceParams) {
+	var request GetUsersAbsenceRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.GetUsersAbsence(ctx, request.(GetUsersAbsenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "GetUsersAbsence")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersAbsenceResponseObject); ok {
+		if err := validResponse.VisitGetUsersAbsenceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -831,11 +3325,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersAbsence operation middleware
+func (sh *strictHandler) PostUsersAbsence(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersAbsenceRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostUsersAbsenceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -843,18 +3337,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersAbsence(ctx, request.(PostUsersAbsenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersAbsence")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersAbsenceResponseObject); ok {
+		if err := validResponse.VisitPostUsersAbsenceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -862,11 +3356,11 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostUsersAbsenceDelete operation middleware
+func (sh *strictHandler) PostUsersAbsenceDelete(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersAbsenceDeleteRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostUsersAbsenceDeleteJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -874,18 +3368,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersAbsenceDelete(ctx, request.(PostUsersAbsenceDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersAbsenceDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersAbsenceDeleteResponseObject); ok {
+		if err := validResponse.VisitPostUsersAbsenceDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -893,25 +3387,58 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// PostUsersAbsenceImport operation middleware
+func (sh *strictHandler) PostUsersAbsenceImport(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceImportParams) {
+	var request PostUsersAbsenceImportRequestObject
 
 	request.Params = params
 
+	request.Body = r.Body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.PostUsersAbsenceImport(ctx, request.(PostUsersAbsenceImportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostUsersAbsenceImport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersAbsenceImportResponseObject); ok {
+		if err := validResponse.VisitPostUsersAbsenceImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersExternalLogin operation middleware
+func (sh *strictHandler) PostUsersExternalLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersExternalLoginRequestObject
+
+	var body PostUsersExternalLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersExternalLogin(ctx, request.(PostUsersExternalLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersExternalLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersExternalLoginResponseObject); ok {
+		if err := validResponse.VisitPostUsersExternalLoginResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1,83 +1,244 @@
 package handlers
 
 import (
-	"encoding/json"
-	"net/http"
+	"context"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/service"
 )
 
-func (s *Server) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var body PostPullRequestCreateJSONRequestBody
+func (s *Server) PostPullRequestCreate(ctx context.Context, req PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error) {
+	body := req.Body
+	if err := required("pull_request_id", body.PullRequestId, "pull_request_name", body.PullRequestName, "author_id", body.AuthorId); err != nil {
+		return nil, err
+	}
+
+	in := service.NewPR{
+		ID:       body.PullRequestId,
+		Name:     body.PullRequestName,
+		AuthorID: body.AuthorId,
+		Draft:    body.Draft != nil && *body.Draft,
+	}
+	if body.Files != nil {
+		in.Files = *body.Files
+	}
+
+	pr, err := s.PRService.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostPullRequestCreate201JSONResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *Server) PostPullRequestMerge(ctx context.Context, req PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error) {
+	if err := required("pull_request_id", req.Body.PullRequestId); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.PRService.Merge(ctx, req.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostPullRequestMerge200JSONResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *Server) PostPullRequestClose(ctx context.Context, req PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error) {
+	if err := required("pull_request_id", req.Body.PullRequestId); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.PRService.Close(ctx, req.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostPullRequestClose200JSONResponse{Pr: toPullRequest(pr)}, nil
+}
+
+func (s *Server) PostPullRequestReopen(ctx context.Context, req PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error) {
+	if err := required("pull_request_id", req.Body.PullRequestId); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.PRService.Reopen(ctx, req.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostPullRequestReopen200JSONResponse{Pr: toPullRequest(pr)}, nil
+}
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, "bad JSON", http.StatusBadRequest)
-		return
+func (s *Server) PostPullRequestReady(ctx context.Context, req PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error) {
+	if err := required("pull_request_id", req.Body.PullRequestId); err != nil {
+		return nil, err
 	}
 
-	pr, err := s.PRService.Create(
-		r.Context(),
-		body.PullRequestId,
-		body.PullRequestName,
-		body.AuthorId,
-	)
+	pr, err := s.PRService.MarkReady(ctx, req.Body.PullRequestId)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, err
 	}
 
-	json.NewEncoder(w).Encode(pr)
+	return PostPullRequestReady200JSONResponse{Pr: toPullRequest(pr)}, nil
 }
 
-func (s *Server) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var body PostPullRequestMergeJSONRequestBody
+func (s *Server) PostPullRequestDraft(ctx context.Context, req PostPullRequestDraftRequestObject) (PostPullRequestDraftResponseObject, error) {
+	if err := required("pull_request_id", req.Body.PullRequestId); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.PRService.MarkDraft(ctx, req.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostPullRequestDraft200JSONResponse{Pr: toPullRequest(pr)}, nil
+}
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, "bad JSON", http.StatusBadRequest)
-		return
+func (s *Server) PostPullRequestReview(ctx context.Context, req PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error) {
+	body := req.Body
+	if err := required("pull_request_id", body.PullRequestId, "reviewer_id", body.ReviewerId); err != nil {
+		return nil, err
 	}
 
-	pr, err := s.PRService.Merge(r.Context(), body.PullRequestId)
+	pr, err := s.PRService.SubmitReview(ctx, body.PullRequestId, body.ReviewerId, domain.ReviewDecision(body.Decision))
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, err
 	}
 
-	json.NewEncoder(w).Encode(pr)
+	return PostPullRequestReview200JSONResponse{Pr: toPullRequest(pr)}, nil
 }
 
-func (s *Server) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		ID                string `json:"id"`
-		ReviewerToReplace string `json:"reviewerId"`
+func (s *Server) PostPullRequestReassign(ctx context.Context, req PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error) {
+	body := req.Body
+	if err := required("pull_request_id", body.PullRequestId, "old_user_id", body.OldUserId); err != nil {
+		return nil, err
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "bad JSON", http.StatusBadRequest)
-		return
+	pr, newID, err := s.PRService.ReassignReviewer(ctx, body.PullRequestId, body.OldUserId)
+	if err != nil {
+		return nil, err
 	}
 
-	pr, newID, err := s.PRService.ReassignReviewer(r.Context(), req.ID, req.ReviewerToReplace)
+	return PostPullRequestReassign200JSONResponse{
+		Pr:         *toPullRequest(pr),
+		ReplacedBy: newID,
+	}, nil
+}
+
+func (s *Server) GetUsersGetReview(ctx context.Context, req GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error) {
+	list, err := s.PRService.GetUserReviews(ctx, req.Params.UserId)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, err
 	}
 
-	resp := struct {
-		NewReviewer string      `json:"newReviewer"`
-		PR          interface{} `json:"pr"`
-	}{
-		NewReviewer: newID,
-		PR:          pr,
+	resp := GetUsersGetReview200JSONResponse{
+		UserId:       req.Params.UserId,
+		PullRequests: []PullRequestShort{},
+	}
+	for _, pr := range list {
+		resp.PullRequests = append(resp.PullRequests, PullRequestShort{
+			PullRequestId:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorId:        pr.AuthorID,
+			Status:          PullRequestShortStatus(pr.Status),
+		})
 	}
+	return resp, nil
+}
 
-	json.NewEncoder(w).Encode(resp)
+func (s *Server) GetPullRequestEscalations(ctx context.Context, req GetPullRequestEscalationsRequestObject) (GetPullRequestEscalationsResponseObject, error) {
+	list, err := s.SLAService.Escalations(ctx, req.Params.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetPullRequestEscalations200JSONResponse{
+		PullRequestId: req.Params.PullRequestId,
+		Escalations:   []Escalation{},
+	}
+	for _, e := range list {
+		item := Escalation{
+			EscalationId: e.ID,
+			ReviewerId:   e.ReviewerID,
+			Kind:         EscalationKind(e.Kind),
+			AssignedAt:   e.AssignedAt,
+			Reason:       e.Reason,
+			CreatedAt:    e.CreatedAt,
+		}
+		if e.ReplacedBy != "" {
+			replacedBy := e.ReplacedBy
+			item.ReplacedBy = &replacedBy
+		}
+		resp.Escalations = append(resp.Escalations, item)
+	}
+	return resp, nil
 }
 
-func (s *Server) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	list, err := s.PRService.GetUserReviews(r.Context(), params.UserId)
+func (s *Server) GetPullRequestHistory(ctx context.Context, req GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error) {
+	list, err := s.PRService.History(ctx, req.Params.PullRequestId)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, err
+	}
+
+	resp := GetPullRequestHistory200JSONResponse{
+		PullRequestId: req.Params.PullRequestId,
+		Events:        []AssignmentEvent{},
+	}
+	for _, e := range list {
+		item := AssignmentEvent{
+			EventId:   e.ID,
+			UserId:    e.UserID,
+			Action:    AssignmentEventAction(e.Action),
+			Reason:    AssignmentEventReason(e.Reason),
+			Actor:     e.Actor,
+			CreatedAt: e.CreatedAt,
+		}
+		if e.Detail != "" {
+			detail := e.Detail
+			item.Detail = &detail
+		}
+		resp.Events = append(resp.Events, item)
+	}
+	return resp, nil
+}
+
+// toPullRequest — domain.PullRequest в форме спецификации.
+func toPullRequest(pr domain.PullRequest) *PullRequest {
+	res := &PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            PullRequestStatus(pr.Status),
+		AssignedReviewers: []string{},
+		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
+	}
+	if !pr.CreatedAt.IsZero() {
+		res.CreatedAt = &pr.CreatedAt
+	}
+	if pr.Reviewers != nil {
+		res.AssignedReviewers = pr.Reviewers
+	}
+	if pr.Files != nil {
+		res.Files = &pr.Files
+	}
+
+	reviews := make([]Review, 0, len(pr.Reviews))
+	for _, rv := range pr.Reviews {
+		r := Review{ReviewerId: rv.ReviewerID, DecidedAt: rv.DecidedAt}
+		if rv.Decision != "" {
+			d := ReviewDecision(rv.Decision)
+			r.Decision = &d
+		}
+		if rv.FallbackTeam != "" {
+			team := rv.FallbackTeam
+			r.FallbackTeam = &team
+		}
+		reviews = append(reviews, r)
 	}
+	res.Reviews = &reviews
 
-	json.NewEncoder(w).Encode(list)
+	return res
 }
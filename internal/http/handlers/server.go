@@ -1,9 +1,16 @@
 package handlers
 
+// Типы и обёртки API генерируются из openapi/openapi.yml версией
+// oapi-codegen из go.mod; актуальность openapi_gen.go проверяет
+// TestGeneratedCodeIsUpToDate.
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config ../../../oapi-codegen.yaml -o openapi_gen.go ../../../openapi/openapi.yml
+
 import (
-	"encoding/json"
 	"net/http"
+
 	"pr-reviewer-service/internal/service"
+
+	"github.com/go-chi/chi/v5"
 )
 
 type Server struct {
@@ -11,6 +18,7 @@ type Server struct {
 	UserService      *service.UserService
 	PRService        *service.PRService
 	TeamAdminService *service.TeamAdminService
+	SLAService       *service.SLAService
 }
 
 func NewServer(
@@ -18,23 +26,49 @@ func NewServer(
 	us *service.UserService,
 	prs *service.PRService,
 	admin *service.TeamAdminService,
+	sla *service.SLAService,
 ) *Server {
 	return &Server{
 		TeamService:      ts,
 		UserService:      us,
 		PRService:        prs,
 		TeamAdminService: admin,
+		SLAService:       sla,
 	}
 }
-func (s *Server) GetStats(w http.ResponseWriter, r *http.Request) {
-	rv, st, err := s.PRService.Stats(r.Context())
-	if err != nil {
-		http.Error(w, err.Error(), 500)
-		return
-	}
 
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"reviewerAssignments": rv,
-		"prStatus":            st,
+// NewHandler монтирует обработчики спецификации на router через
+// strict-обёртку: тела запросов декодируются и ответы кодируются
+// по типам из openapi_gen.go, а ошибки уходят в writeError.
+func NewHandler(s *Server, router chi.Router) http.Handler {
+	strict := NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			badRequest(w, err.Error())
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, err)
+		},
+	})
+
+	return HandlerWithOptions(strict, ChiServerOptions{
+		BaseRouter:  router,
+		Middlewares: []MiddlewareFunc{actor},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			badRequest(w, err.Error())
+		},
+	})
+}
+
+// ActorHeader — кто инициировал запрос (пользователь, бот); попадает
+// в журнал назначений. Без заголовка актор — "api".
+const ActorHeader = "X-Actor"
+
+func actor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.Header.Get(ActorHeader)
+		if name == "" {
+			name = "api"
+		}
+		next.ServeHTTP(w, r.WithContext(service.WithActor(r.Context(), name)))
 	})
 }
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+func statsFilter(team *string, from, to *time.Time) domain.StatsFilter {
+	filter := domain.StatsFilter{From: from, To: to}
+	if team != nil {
+		filter.Team = *team
+	}
+	return filter
+}
+
+func (s *Server) GetStats(ctx context.Context, req GetStatsRequestObject) (GetStatsResponseObject, error) {
+	stats, err := s.PRService.Stats(ctx, statsFilter(req.Params.Team, req.Params.From, req.Params.To))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetStats200JSONResponse(toStats(stats)), nil
+}
+
+// toStats — domain.Stats в форме спецификации. reviewerAssignments
+// и prStatus сохранены в прежнем виде для старых клиентов.
+func toStats(stats domain.Stats) Stats {
+	res := Stats{
+		ReviewerAssignments: map[string]int{},
+		PrStatus:            map[string]int{},
+		Teams:               []TeamStats{},
+		Users:               []UserStats{},
+	}
+	for status, n := range stats.PRStatus {
+		res.PrStatus[string(status)] = n
+	}
+	for _, u := range stats.Users {
+		if u.Assignments > 0 {
+			res.ReviewerAssignments[u.UserID] = u.Assignments
+		}
+		res.Users = append(res.Users, UserStats{
+			UserId:            u.UserID,
+			TeamName:          u.TeamName,
+			Assignments:       u.Assignments,
+			OpenAssignments:   u.OpenAssignments,
+			MergedAssignments: u.MergedAssignments,
+			AuthoredPrs:       u.AuthoredPRs,
+		})
+	}
+	for _, t := range stats.Teams() {
+		res.Teams = append(res.Teams, TeamStats{
+			TeamName:          t.TeamName,
+			Assignments:       t.Assignments,
+			OpenAssignments:   t.OpenAssignments,
+			MergedAssignments: t.MergedAssignments,
+			AuthoredPrs:       t.AuthoredPRs,
+		})
+	}
+	return res
+}
+
+func (s *Server) GetStatsTurnaround(ctx context.Context, req GetStatsTurnaroundRequestObject) (GetStatsTurnaroundResponseObject, error) {
+	t, err := s.PRService.Turnaround(ctx, statsFilter(req.Params.Team, req.Params.From, req.Params.To))
+	if err != nil {
+		return nil, err
+	}
+
+	res := GetStatsTurnaround200JSONResponse{
+		Teams:     []TeamTurnaround{},
+		Reviewers: []ReviewerTurnaround{},
+	}
+	for _, team := range t.Teams {
+		res.Teams = append(res.Teams, TeamTurnaround{
+			TeamName:     team.TeamName,
+			TimeToMerge:  toDurationPercentiles(team.TimeToMerge),
+			TimeInReview: toDurationPercentiles(team.TimeInReview),
+		})
+	}
+	for _, r := range t.Reviewers {
+		res.Reviewers = append(res.Reviewers, ReviewerTurnaround{
+			UserId:       r.UserID,
+			TeamName:     r.TeamName,
+			TimeToMerge:  toDurationPercentiles(r.TimeToMerge),
+			TimeInReview: toDurationPercentiles(r.TimeInReview),
+		})
+	}
+	return res, nil
+}
+
+// toDurationPercentiles — перцентили в секундах, как в спецификации.
+func toDurationPercentiles(p domain.Percentiles) DurationPercentiles {
+	return DurationPercentiles{
+		Count: p.Count,
+		P50:   p.P50.Seconds(),
+		P90:   p.P90.Seconds(),
+		P99:   p.P99.Seconds(),
+	}
+}
+
+func (s *Server) GetStatsFairness(ctx context.Context, req GetStatsFairnessRequestObject) (GetStatsFairnessResponseObject, error) {
+	report, err := s.PRService.Fairness(ctx, statsFilter(req.Params.Team, req.Params.From, req.Params.To))
+	if err != nil {
+		return nil, err
+	}
+
+	res := GetStatsFairness200JSONResponse{
+		From:  report.From,
+		To:    report.To,
+		Weeks: report.Weeks,
+		Teams: []TeamFairness{},
+	}
+	for _, t := range report.Teams {
+		team := TeamFairness{
+			TeamName:    t.TeamName,
+			Assignments: t.Assignments,
+			Mean:        t.Mean,
+			Gini:        t.Gini,
+			Members:     []MemberFairness{},
+		}
+		for _, m := range t.Members {
+			mf := MemberFairness{
+				UserId:      m.UserID,
+				Assignments: m.Assignments,
+				Deviation:   m.Deviation,
+				OverWeeks:   m.OverWeeks,
+				UnderWeeks:  m.UnderWeeks,
+			}
+			if m.Flag != "" {
+				flag := MemberFairnessFlag(m.Flag)
+				mf.Flag = &flag
+			}
+			team.Members = append(team.Members, mf)
+		}
+		res.Teams = append(res.Teams, team)
+	}
+	return res, nil
+}
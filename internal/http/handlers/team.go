@@ -1,17 +1,17 @@
 package handlers
 
 import (
-	"encoding/json"
-	"errors"
-	"net/http"
+	"context"
+	"strings"
+
+	"pr-reviewer-service/internal/codeowners"
 	"pr-reviewer-service/internal/domain"
 )
 
-func (s *Server) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var body PostTeamAddJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, "invalid json", http.StatusBadRequest)
-		return
+func (s *Server) PostTeamAdd(ctx context.Context, req PostTeamAddRequestObject) (PostTeamAddResponseObject, error) {
+	body := req.Body
+	if err := required("team_name", body.TeamName); err != nil {
+		return nil, err
 	}
 
 	// OpenAPI модель → domain модель
@@ -29,64 +29,153 @@ func (s *Server) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 		Members: members,
 	}
 
-	err := s.TeamService.CreateWithMembers(r.Context(), team)
+	// TEAM_EXISTS по спецификации отдаётся с 400 — см. errorMapping
+	if err := s.TeamService.CreateWithMembers(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return PostTeamAdd201JSONResponse{Team: toTeam(team)}, nil
+}
+
+func (s *Server) GetTeamGet(ctx context.Context, req GetTeamGetRequestObject) (GetTeamGetResponseObject, error) {
+	team, err := s.TeamService.Get(ctx, req.Params.TeamName)
 	if err != nil {
-		if errors.Is(err, domain.ErrTeamExists) {
-			// открываем согласно openapi: 400 + код TEAM_EXISTS
-			resp := PostTeamAdd400JSONResponse{
-				Error: struct {
-					Code    ErrorResponseErrorCode `json:"code"`
-					Message string                 `json:"message"`
-				}{
-					Code:    TEAMEXISTS,
-					Message: "team already exists",
-				},
-			}
-			resp.VisitPostTeamAddResponse(w)
-			return
-		}
+		return nil, err
+	}
+
+	return GetTeamGet200JSONResponse(*toTeam(team)), nil
+}
+
+func toTeam(team *domain.Team) *Team {
+	res := &Team{TeamName: team.Name, Members: []TeamMember{}}
+	for _, m := range team.Members {
+		res.Members = append(res.Members, TeamMember{
+			UserId:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+	return res
+}
 
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+func (s *Server) PostTeamDeactivate(ctx context.Context, req PostTeamDeactivateRequestObject) (PostTeamDeactivateResponseObject, error) {
+	if err := required("team", req.Body.Team); err != nil {
+		return nil, err
 	}
 
-	resp := PostTeamAdd201JSONResponse{
-		Team: &Team{
-			TeamName: body.TeamName,
-			Members:  body.Members,
-		},
+	if err := s.TeamAdminService.DeactivateTeam(ctx, req.Body.Team); err != nil {
+		return nil, err
+	}
+
+	return PostTeamDeactivate200JSONResponse{Status: "ok"}, nil
+}
+
+func (s *Server) GetTeamSettings(ctx context.Context, req GetTeamSettingsRequestObject) (GetTeamSettingsResponseObject, error) {
+	settings, err := s.TeamService.GetSettings(ctx, req.Params.TeamName)
+	if err != nil {
+		return nil, err
 	}
-	resp.VisitPostTeamAddResponse(w)
+
+	return GetTeamSettings200JSONResponse(toTeamSettings(settings)), nil
 }
 
-func (s *Server) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	team, err := s.TeamService.Get(r.Context(), params.TeamName)
+func (s *Server) PostTeamSettings(ctx context.Context, req PostTeamSettingsRequestObject) (PostTeamSettingsResponseObject, error) {
+	body := req.Body
+	if err := required("team_name", body.TeamName); err != nil {
+		return nil, err
+	}
+
+	// частичное обновление: берём текущие настройки и накладываем переданные поля
+	settings, err := s.TeamService.GetSettings(ctx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if body.ReviewerStrategy != nil {
+		settings.ReviewerStrategy = domain.ReviewerStrategy(*body.ReviewerStrategy)
+	}
+	if body.ReviewerCount != nil {
+		settings.ReviewerCount = *body.ReviewerCount
+	}
+	if body.MinApprovals != nil {
+		settings.MinApprovals = *body.MinApprovals
+	}
+	if body.FallbackTeams != nil {
+		settings.FallbackTeams = *body.FallbackTeams
+	}
+	if body.ReviewSlaHours != nil {
+		settings.ReviewSLAHours = *body.ReviewSlaHours
+	}
+	if body.EscalationHours != nil {
+		settings.EscalationHours = *body.EscalationHours
+	}
+
+	settings, err = s.TeamService.UpdateSettings(ctx, settings)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
+		return nil, err
 	}
 
-	json.NewEncoder(w).Encode(team)
+	return PostTeamSettings200JSONResponse(toTeamSettings(settings)), nil
+}
+
+func toTeamSettings(settings domain.TeamSettings) TeamSettings {
+	strategy := TeamSettingsReviewerStrategy(settings.ReviewerStrategy)
+	if settings.FallbackTeams == nil {
+		settings.FallbackTeams = []string{}
+	}
+	return TeamSettings{
+		TeamName:         settings.TeamName,
+		ReviewerStrategy: &strategy,
+		ReviewerCount:    &settings.ReviewerCount,
+		MinApprovals:     &settings.MinApprovals,
+		FallbackTeams:    &settings.FallbackTeams,
+		ReviewSlaHours:   &settings.ReviewSLAHours,
+		EscalationHours:  &settings.EscalationHours,
+	}
 }
-func (s *Server) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Team string `json:"team"`
+
+func (s *Server) GetTeamCodeowners(ctx context.Context, req GetTeamCodeownersRequestObject) (GetTeamCodeownersResponseObject, error) {
+	co, rules, err := s.TeamService.GetCodeowners(ctx, req.Params.TeamName)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "bad JSON", http.StatusBadRequest)
-		return
+	return GetTeamCodeowners200JSONResponse(toTeamCodeowners(co, rules)), nil
+}
+
+func (s *Server) PostTeamCodeowners(ctx context.Context, req PostTeamCodeownersRequestObject) (PostTeamCodeownersResponseObject, error) {
+	var content string
+	if req.Body != nil {
+		content = *req.Body
 	}
-	if req.Team == "" {
-		http.Error(w, "team is required", http.StatusBadRequest)
-		return
+
+	co, rules, err := s.TeamService.SetCodeowners(ctx, req.Params.TeamName, strings.NewReader(content))
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.TeamAdminService.DeactivateTeam(r.Context(), req.Team); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+	return PostTeamCodeowners200JSONResponse(toTeamCodeowners(co, rules)), nil
+}
+
+func toTeamCodeowners(co domain.TeamCodeowners, rules codeowners.Ruleset) TeamCodeowners {
+	res := TeamCodeowners{
+		TeamName: co.TeamName,
+		Content:  co.Content,
+		Rules:    []CodeownersRule{},
+	}
+	if !co.UpdatedAt.IsZero() {
+		res.UpdatedAt = &co.UpdatedAt
 	}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(`{"status":"ok"}`))
+	for _, rule := range rules {
+		owners := []string{}
+		for _, o := range rule.Owners {
+			owners = append(owners, o.Raw)
+		}
+		res.Rules = append(res.Rules, CodeownersRule{
+			Line:    rule.Line,
+			Pattern: rule.Pattern,
+			Owners:  owners,
+		})
+	}
+	return res
 }
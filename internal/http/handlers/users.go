@@ -1,26 +1,120 @@
 package handlers
 
 import (
-	"encoding/json"
-	"net/http"
+	"context"
+
+	"pr-reviewer-service/internal/domain"
 )
 
-func (s *Server) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		UserID   string `json:"user_id"`
-		IsActive bool   `json:"is_active"`
+func (s *Server) PostUsersSetIsActive(ctx context.Context, req PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error) {
+	if err := required("user_id", req.Body.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.UserService.SetActive(ctx, req.Body.UserId, req.Body.IsActive); err != nil {
+		return nil, err
+	}
+
+	u, err := s.UserService.Get(ctx, req.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostUsersSetIsActive200JSONResponse{User: &User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}}, nil
+}
+
+func (s *Server) PostUsersExternalLogin(ctx context.Context, req PostUsersExternalLoginRequestObject) (PostUsersExternalLoginResponseObject, error) {
+	body := req.Body
+	if err := required("user_id", body.UserId, "login", body.Login); err != nil {
+		return nil, err
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "bad JSON", http.StatusBadRequest)
-		return
+	err := s.UserService.LinkLogin(ctx, domain.ExternalLogin{
+		Provider: domain.Provider(body.Provider),
+		Login:    body.Login,
+		UserID:   body.UserId,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	err := s.UserService.SetActive(r.Context(), req.UserID, req.IsActive)
+	return PostUsersExternalLogin200JSONResponse(*body), nil
+}
+
+func (s *Server) GetUsersAbsence(ctx context.Context, req GetUsersAbsenceRequestObject) (GetUsersAbsenceResponseObject, error) {
+	list, err := s.UserService.ListAbsences(ctx, req.Params.UserId)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, err
+	}
+
+	return GetUsersAbsence200JSONResponse(toUserAbsences(req.Params.UserId, list)), nil
+}
+
+func (s *Server) PostUsersAbsence(ctx context.Context, req PostUsersAbsenceRequestObject) (PostUsersAbsenceResponseObject, error) {
+	body := req.Body
+	if err := required("user_id", body.UserId); err != nil {
+		return nil, err
+	}
+
+	absence := domain.Absence{
+		UserID:   body.UserId,
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+	}
+	if body.Reason != nil {
+		absence.Reason = *body.Reason
 	}
 
-	w.WriteHeader(http.StatusOK)
+	absence, err := s.UserService.AddAbsence(ctx, absence)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toAbsence(absence)
+	return PostUsersAbsence201JSONResponse{Absence: &res}, nil
+}
+
+func (s *Server) PostUsersAbsenceDelete(ctx context.Context, req PostUsersAbsenceDeleteRequestObject) (PostUsersAbsenceDeleteResponseObject, error) {
+	if err := s.UserService.DeleteAbsence(ctx, req.Body.UserId, req.Body.AbsenceId); err != nil {
+		return nil, err
+	}
+
+	return PostUsersAbsenceDelete204Response{}, nil
+}
+
+func (s *Server) PostUsersAbsenceImport(ctx context.Context, req PostUsersAbsenceImportRequestObject) (PostUsersAbsenceImportResponseObject, error) {
+	list, err := s.UserService.ImportAbsences(ctx, req.Params.UserId, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostUsersAbsenceImport201JSONResponse(toUserAbsences(req.Params.UserId, list)), nil
+}
+
+func toAbsence(a domain.Absence) Absence {
+	res := Absence{
+		AbsenceId: a.ID,
+		UserId:    a.UserID,
+		StartsAt:  a.StartsAt,
+		EndsAt:    a.EndsAt,
+		Reason:    a.Reason,
+	}
+	if a.UID != "" {
+		uid := a.UID
+		res.Uid = &uid
+	}
+	return res
+}
+
+func toUserAbsences(userID string, list []domain.Absence) UserAbsences {
+	res := UserAbsences{UserId: userID, Absences: []Absence{}}
+	for _, a := range list {
+		res.Absences = append(res.Absences, toAbsence(a))
+	}
+	return res
 }
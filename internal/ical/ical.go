@@ -0,0 +1,267 @@
+// Package ical разбирает события (VEVENT) из файлов iCalendar (RFC 5545)
+// ровно в том объёме, который нужен для импорта отсутствий: интервал и описание.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	// TZID из Outlook/Google-экспортов должен разрешаться и в образе без tzdata
+	_ "time/tzdata"
+)
+
+// Event — одно событие календаря. End не включается в интервал.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+}
+
+var (
+	ErrMalformed = errors.New("malformed iCalendar")
+	// ErrRecurring — повторяющееся событие (RRULE, RDATE, RECURRENCE-ID):
+	// интервалы повторений не разворачиваются, а взять только первый
+	// значило бы молча потерять остальные.
+	ErrRecurring = errors.New("recurring events are not supported")
+)
+
+// Parse читает календарь и возвращает все неотменённые события.
+// Повторяющиеся события отклоняются с ErrRecurring.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []Event
+		cur     *rawEvent
+		sawCal  bool
+		lineNum int
+	)
+	for _, line := range lines {
+		lineNum++
+		if line == "" {
+			continue
+		}
+
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrMalformed, lineNum, line)
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCALENDAR"):
+			sawCal = true
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			cur = &rawEvent{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if cur == nil {
+				return nil, fmt.Errorf("%w: line %d: END:VEVENT without BEGIN", ErrMalformed, lineNum)
+			}
+			ev, keep, err := cur.build()
+			if errors.Is(err, ErrRecurring) {
+				return nil, fmt.Errorf("%w: event %q", err, cur.uid)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%w: event %q: %v", ErrMalformed, cur.uid, err)
+			}
+			if keep {
+				events = append(events, ev)
+			}
+			cur = nil
+		case cur != nil:
+			cur.set(name, params, value)
+		}
+	}
+
+	if !sawCal {
+		return nil, fmt.Errorf("%w: no VCALENDAR", ErrMalformed)
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("%w: unterminated VEVENT", ErrMalformed)
+	}
+	return events, nil
+}
+
+// unfold склеивает перенесённые строки (продолжение начинается с пробела или таба).
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, sc.Err()
+}
+
+// splitProperty разбирает "NAME;PARAM=V;PARAM2=V2:value".
+func splitProperty(line string) (name string, params map[string]string, value string, ok bool) {
+	colon := -1
+	inQuotes := false
+	for i, c := range line {
+		if c == '"' {
+			inQuotes = !inQuotes
+		}
+		if c == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	name = strings.ToUpper(parts[0])
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return name, params, line[colon+1:], true
+}
+
+type rawEvent struct {
+	uid, summary, status string
+	start, end           *dateValue
+	duration             string
+	recurring            bool
+}
+
+type dateValue struct {
+	params map[string]string
+	value  string
+}
+
+func (e *rawEvent) set(name string, params map[string]string, value string) {
+	switch name {
+	case "UID":
+		e.uid = value
+	case "SUMMARY":
+		e.summary = unescape(value)
+	case "STATUS":
+		e.status = strings.ToUpper(value)
+	case "DTSTART":
+		e.start = &dateValue{params: params, value: value}
+	case "DTEND":
+		e.end = &dateValue{params: params, value: value}
+	case "DURATION":
+		e.duration = value
+	case "RRULE", "RDATE", "RECURRENCE-ID":
+		e.recurring = true
+	}
+}
+
+func (e *rawEvent) build() (Event, bool, error) {
+	if e.status == "CANCELLED" {
+		return Event{}, false, nil
+	}
+	if e.recurring {
+		return Event{}, false, ErrRecurring
+	}
+	if e.start == nil {
+		return Event{}, false, errors.New("missing DTSTART")
+	}
+
+	start, allDay, err := parseDate(e.start)
+	if err != nil {
+		return Event{}, false, fmt.Errorf("DTSTART: %w", err)
+	}
+
+	var end time.Time
+	switch {
+	case e.end != nil:
+		end, _, err = parseDate(e.end)
+		if err != nil {
+			return Event{}, false, fmt.Errorf("DTEND: %w", err)
+		}
+	case e.duration != "":
+		d, err := parseDuration(e.duration)
+		if err != nil {
+			return Event{}, false, fmt.Errorf("DURATION: %w", err)
+		}
+		end = start.Add(d)
+	case allDay:
+		// RFC 5545: событие-дата без DTEND длится один день
+		end = start.AddDate(0, 0, 1)
+	default:
+		return Event{}, false, errors.New("missing DTEND")
+	}
+
+	if !end.After(start) {
+		return Event{}, false, errors.New("DTEND must be after DTSTART")
+	}
+
+	return Event{UID: e.uid, Summary: e.summary, Start: start, End: end}, true, nil
+}
+
+// parseDate понимает DATE (20250101), DATE-TIME в UTC (20250101T090000Z),
+// с TZID и «плавающее» локальное время (трактуется как UTC).
+func parseDate(v *dateValue) (time.Time, bool, error) {
+	if strings.EqualFold(v.params["VALUE"], "DATE") || len(v.value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", v.value, time.UTC)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(v.value, "Z") {
+		t, err := time.Parse("20060102T150405Z", v.value)
+		return t.UTC(), false, err
+	}
+
+	loc := time.UTC
+	if tz := v.params["TZID"]; tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tz)
+		}
+		loc = l
+	}
+	t, err := time.ParseInLocation("20060102T150405", v.value, loc)
+	return t.UTC(), false, err
+}
+
+var durationRe = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration разбирает DURATION вида P1W, P2D, PT8H, P1DT12H30M.
+func parseDuration(s string) (time.Duration, error) {
+	m := durationRe.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" {
+		return 0, fmt.Errorf("bad duration %q", s)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, u := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * u
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+var unescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
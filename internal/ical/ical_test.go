@@ -0,0 +1,172 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// calendar собирает VCALENDAR из строк с переводами CRLF, как в экспортах.
+func calendar(lines ...string) string {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}, lines...)
+	all = append(all, "END:VCALENDAR")
+	return strings.Join(all, "\r\n") + "\r\n"
+}
+
+func utc(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+		want []Event
+	}{
+		{
+			name: "UTC date-time",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:a@test", "SUMMARY:Conference",
+				"DTSTART:20250602T090000Z", "DTEND:20250602T180000Z", "END:VEVENT",
+			),
+			want: []Event{{UID: "a@test", Summary: "Conference", Start: utc(2025, 6, 2, 9, 0), End: utc(2025, 6, 2, 18, 0)}},
+		},
+		{
+			name: "VALUE=DATE, DTEND not included",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:vacation", "SUMMARY:Vacation",
+				"DTSTART;VALUE=DATE:20250602", "DTEND;VALUE=DATE:20250609", "END:VEVENT",
+			),
+			want: []Event{{UID: "vacation", Summary: "Vacation", Start: utc(2025, 6, 2, 0, 0), End: utc(2025, 6, 9, 0, 0)}},
+		},
+		{
+			name: "date without DTEND lasts a day",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:day-off", "DTSTART;VALUE=DATE:20250602", "END:VEVENT",
+			),
+			want: []Event{{UID: "day-off", Start: utc(2025, 6, 2, 0, 0), End: utc(2025, 6, 3, 0, 0)}},
+		},
+		{
+			name: "TZID",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:tz",
+				`DTSTART;TZID="Europe/Moscow":20250602T090000`,
+				"DTEND;TZID=America/New_York:20250602T090000", "END:VEVENT",
+			),
+			// Москва UTC+3, Нью-Йорк летом UTC-4
+			want: []Event{{UID: "tz", Start: utc(2025, 6, 2, 6, 0), End: utc(2025, 6, 2, 13, 0)}},
+		},
+		{
+			name: "floating time is UTC",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:float", "DTSTART:20250602T090000", "DURATION:P1DT2H30M", "END:VEVENT",
+			),
+			want: []Event{{UID: "float", Start: utc(2025, 6, 2, 9, 0), End: utc(2025, 6, 3, 11, 30)}},
+		},
+		{
+			name: "folded lines and escapes",
+			ics: calendar(
+				"BEGIN:VEVENT",
+				"UID:folded-",
+				" uid@test",
+				"SUMMARY:Sick leave\\, then",
+				"\t trip to Kazan\\; back on Monday",
+				"DTSTART;VALUE=DATE:",
+				" 20250602",
+				"DTEND;VALUE=DATE:20250604",
+				"END:VEVENT",
+			),
+			want: []Event{{
+				UID: "folded-uid@test", Summary: "Sick leave, then trip to Kazan; back on Monday",
+				Start: utc(2025, 6, 2, 0, 0), End: utc(2025, 6, 4, 0, 0),
+			}},
+		},
+		{
+			name: "cancelled events are skipped",
+			ics: calendar(
+				"BEGIN:VEVENT", "UID:cancelled", "STATUS:CANCELLED", "DTSTART;VALUE=DATE:20250602", "END:VEVENT",
+				"BEGIN:VEVENT", "UID:kept", "DTSTART;VALUE=DATE:20250603", "END:VEVENT",
+			),
+			want: []Event{{UID: "kept", Start: utc(2025, 6, 3, 0, 0), End: utc(2025, 6, 4, 0, 0)}},
+		},
+		{
+			name: "other components are ignored",
+			ics: calendar(
+				"BEGIN:VTIMEZONE", "TZID:Europe/Moscow", "END:VTIMEZONE",
+				"BEGIN:VEVENT", "UID:only", "DTSTART;VALUE=DATE:20250602", "END:VEVENT",
+			),
+			want: []Event{{UID: "only", Start: utc(2025, 6, 2, 0, 0), End: utc(2025, 6, 3, 0, 0)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.ics))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("events = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				g, w := got[i], tt.want[i]
+				if g.UID != w.UID || g.Summary != w.Summary || !g.Start.Equal(w.Start) || !g.End.Equal(w.End) {
+					t.Errorf("event %d = %+v, want %+v", i, g, w)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRejectsRecurringEvents(t *testing.T) {
+	for _, prop := range []string{
+		"RRULE:FREQ=WEEKLY;BYDAY=FR;COUNT=4",
+		"RDATE;VALUE=DATE:20250609,20250616",
+		"RECURRENCE-ID;VALUE=DATE:20250609",
+	} {
+		t.Run(strings.SplitN(prop, ":", 2)[0], func(t *testing.T) {
+			ics := calendar(
+				"BEGIN:VEVENT", "UID:weekly", "SUMMARY:Day off",
+				"DTSTART;VALUE=DATE:20250606", prop, "END:VEVENT",
+			)
+			_, err := Parse(strings.NewReader(ics))
+			if !errors.Is(err, ErrRecurring) {
+				t.Errorf("err = %v, want ErrRecurring", err)
+			}
+		})
+	}
+
+	// отменённое повторяющееся событие просто пропускается
+	ics := calendar(
+		"BEGIN:VEVENT", "UID:weekly", "STATUS:CANCELLED",
+		"DTSTART;VALUE=DATE:20250606", "RRULE:FREQ=WEEKLY", "END:VEVENT",
+	)
+	if events, err := Parse(strings.NewReader(ics)); err != nil || len(events) != 0 {
+		t.Errorf("cancelled recurring event: %+v, %v", events, err)
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+	}{
+		{"no calendar", "BEGIN:VEVENT\r\nEND:VEVENT\r\n"},
+		{"unterminated event", calendar("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20250602")},
+		{"END without BEGIN", calendar("END:VEVENT")},
+		{"line without colon", calendar("BEGIN:VEVENT", "DTSTART", "END:VEVENT")},
+		{"missing DTSTART", calendar("BEGIN:VEVENT", "SUMMARY:x", "END:VEVENT")},
+		{"missing DTEND", calendar("BEGIN:VEVENT", "DTSTART:20250602T090000Z", "END:VEVENT")},
+		{"end before start", calendar("BEGIN:VEVENT", "DTSTART:20250602T090000Z", "DTEND:20250602T080000Z", "END:VEVENT")},
+		{"bad date", calendar("BEGIN:VEVENT", "DTSTART:2025-06-02", "DTEND:20250603T000000Z", "END:VEVENT")},
+		{"unknown TZID", calendar("BEGIN:VEVENT", "DTSTART;TZID=Mars/Olympus:20250602T090000", "DURATION:PT1H", "END:VEVENT")},
+		{"bad duration", calendar("BEGIN:VEVENT", "DTSTART:20250602T090000Z", "DURATION:PT", "END:VEVENT")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.ics)); !errors.Is(err, ErrMalformed) {
+				t.Errorf("err = %v, want ErrMalformed", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Package metrics — метрики сервиса в формате Prometheus (GET /metrics):
+// HTTP-запросы по маршрутам chi, пул соединений с БД и доменные счётчики.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+const namespace = "pr_reviewer"
+
+type Metrics struct {
+	reg *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	prsCreated    prometheus.Counter
+	prsMerged     prometheus.Counter
+	reassignments *prometheus.CounterVec
+	noCandidate   prometheus.Counter
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		reg: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by route pattern, method and status code.",
+		}, []string{"route", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by route pattern and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "prs_created_total",
+			Help:      "Pull requests created.",
+		}),
+		prsMerged: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "prs_merged_total",
+			Help:      "Pull requests merged.",
+		}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Reviewers replaced, by reason: reassign (API), sla_escalation, deactivation.",
+		}, []string{"reason"}),
+		noCandidate: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "no_candidate_total",
+			Help:      "Reviewer selections that found no available candidate (ErrNoCandidate).",
+		}),
+	}
+
+	// причины заранее, чтобы ряды были видны с нуля
+	for _, reason := range []domain.AssignmentReason{
+		domain.ReasonReassign, domain.ReasonSLAEscalation, domain.ReasonDeactivation,
+	} {
+		m.reassignments.WithLabelValues(string(reason))
+	}
+
+	m.reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests, m.httpDuration,
+		m.prsCreated, m.prsMerged, m.reassignments, m.noCandidate,
+	)
+	return m
+}
+
+// Register добавляет коллектор, например статистику пула БД.
+func (m *Metrics) Register(c prometheus.Collector) {
+	m.reg.MustRegister(c)
+}
+
+// Handler отдаёт метрики в текстовом формате Prometheus.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.reg, promhttp.HandlerOpts{})
+}
+
+// Middleware считает запросы и их длительность. Маршрут берётся из шаблона
+// chi (/team/get, а не /team/get?team_name=x), поэтому число рядов не растёт
+// от параметров; запросы мимо маршрутов попадают в route="unmatched".
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			if p := rctx.RoutePattern(); p != "" {
+				route = p
+			}
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		m.httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(status)).Inc()
+		m.httpDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// ----------------- DOMAIN -----------------
+
+func (m *Metrics) PRCreated() { m.prsCreated.Inc() }
+
+func (m *Metrics) PRMerged() { m.prsMerged.Inc() }
+
+func (m *Metrics) ReviewerReassigned(reason domain.AssignmentReason) {
+	m.reassignments.WithLabelValues(string(reason)).Inc()
+}
+
+func (m *Metrics) NoCandidate() { m.noCandidate.Inc() }
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector снимает pgxpool.Stat при каждом запросе /metrics.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns     *prometheus.Desc
+	idleConns         *prometheus.Desc
+	constructingConns *prometheus.Desc
+	totalConns        *prometheus.Desc
+	maxConns          *prometheus.Desc
+	acquires          *prometheus.Desc
+	acquireSeconds    *prometheus.Desc
+	canceledAcquires  *prometheus.Desc
+	emptyAcquires     *prometheus.Desc
+	newConns          *prometheus.Desc
+	lifetimeDestroys  *prometheus.Desc
+	idleDestroys      *prometheus.Desc
+}
+
+// NewPoolCollector — статистика пула соединений PostgreSQL.
+func NewPoolCollector(pool *pgxpool.Pool) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:              pool,
+		acquiredConns:     desc("acquired_conns", "Connections currently in use."),
+		idleConns:         desc("idle_conns", "Idle connections in the pool."),
+		constructingConns: desc("constructing_conns", "Connections being established."),
+		totalConns:        desc("total_conns", "All connections in the pool."),
+		maxConns:          desc("max_conns", "Maximum pool size."),
+		acquires:          desc("acquires_total", "Successful connection acquires."),
+		acquireSeconds:    desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		canceledAcquires:  desc("canceled_acquires_total", "Acquires canceled by context."),
+		emptyAcquires:     desc("empty_acquires_total", "Acquires that had to wait for a connection."),
+		newConns:          desc("new_conns_total", "Connections opened."),
+		lifetimeDestroys:  desc("max_lifetime_destroys_total", "Connections closed due to max lifetime."),
+		idleDestroys:      desc("max_idle_destroys_total", "Connections closed due to max idle time."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	gauge := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v)
+	}
+	counter := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, v)
+	}
+
+	gauge(c.acquiredConns, float64(s.AcquiredConns()))
+	gauge(c.idleConns, float64(s.IdleConns()))
+	gauge(c.constructingConns, float64(s.ConstructingConns()))
+	gauge(c.totalConns, float64(s.TotalConns()))
+	gauge(c.maxConns, float64(s.MaxConns()))
+	counter(c.acquires, float64(s.AcquireCount()))
+	counter(c.acquireSeconds, s.AcquireDuration().Seconds())
+	counter(c.canceledAcquires, float64(s.CanceledAcquireCount()))
+	counter(c.emptyAcquires, float64(s.EmptyAcquireCount()))
+	counter(c.newConns, float64(s.NewConnsCount()))
+	counter(c.lifetimeDestroys, float64(s.MaxLifetimeDestroyCount()))
+	counter(c.idleDestroys, float64(s.MaxIdleDestroyCount()))
+}
@@ -0,0 +1,148 @@
+// Package notify доставляет уведомления сервиса во внешние вебхуки
+// (боты чатов и т.п.).
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// SignatureHeader — HMAC-SHA256 тела запроса секретом получателя,
+// в том же формате, что и у GitHub: "sha256=<hex>". EventIDHeader
+// совпадает с полем id тела — по нему получатель отбрасывает повторы.
+const (
+	SignatureHeader = "X-Signature-256"
+	EventHeader     = "X-Event"
+	EventIDHeader   = "X-Event-Id"
+)
+
+type Config struct {
+	// URLs — получатели; каждое уведомление отправляется всем.
+	URLs   []string
+	Secret string
+
+	// MaxAttempts — попыток доставки на одного получателя за вызов
+	// Notify (по умолчанию 3); дальше повторяет outbox relay.
+	MaxAttempts int
+	// Backoff — пауза перед второй попыткой, дальше удваивается
+	// до MaxBackoff (по умолчанию 1s и 10s).
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	Client *http.Client
+}
+
+// Webhook — service.Notifier, отправляющий подписанный JSON на URLs.
+type Webhook struct {
+	cfg Config
+}
+
+func NewWebhook(cfg Config) *Webhook {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 10 * time.Second
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Webhook{cfg: cfg}
+}
+
+// Notify отправляет n всем получателям. Ошибка — хотя бы один не принял
+// уведомление после всех попыток; если все не принявшие отказали
+// окончательно (4xx кроме 429), ошибка оборачивает domain.ErrUndeliverable.
+func (w *Webhook) Notify(ctx context.Context, n domain.Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	retry := false
+	for _, url := range w.cfg.URLs {
+		if retryable, err := w.deliver(ctx, url, n, body); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+			retry = retry || retryable
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	if !retry {
+		return fmt.Errorf("%w: %w", domain.ErrUndeliverable, errors.Join(errs...))
+	}
+	return errors.Join(errs...)
+}
+
+// deliver отправляет body с повторами: сетевые ошибки, 429 и 5xx
+// повторяются с экспоненциальной паузой, остальные 4xx — нет.
+// retry — имеет ли смысл повторить доставку позже.
+func (w *Webhook) deliver(ctx context.Context, url string, n domain.Notification, body []byte) (retry bool, err error) {
+	backoff := w.cfg.Backoff
+
+	for attempt := 1; ; attempt++ {
+		retry, err = w.post(ctx, url, n, body)
+		if err == nil || !retry || attempt == w.cfg.MaxAttempts {
+			return retry, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, w.cfg.MaxBackoff)
+	}
+}
+
+func (w *Webhook) post(ctx context.Context, url string, n domain.Notification, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(n.Kind))
+	req.Header.Set(EventIDHeader, n.ID)
+	if w.cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.cfg.Secret, body))
+	}
+
+	resp, err := w.cfg.Client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck // тело ответа не нужно
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("status %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("status %d", resp.StatusCode)
+	}
+}
+
+// Sign — значение SignatureHeader для body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,199 @@
+package notify_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/notify"
+)
+
+// receiver — тестовый получатель: отвечает статусами из statuses по очереди
+// (дальше — 200) и запоминает принятые запросы.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []received
+}
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+func (rv *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rv.mu.Lock()
+	defer rv.mu.Unlock()
+	rv.requests = append(rv.requests, received{header: r.Header.Clone(), body: body})
+
+	status := http.StatusOK
+	if len(rv.statuses) > 0 {
+		status, rv.statuses = rv.statuses[0], rv.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (rv *receiver) got() []received {
+	rv.mu.Lock()
+	defer rv.mu.Unlock()
+	return append([]received(nil), rv.requests...)
+}
+
+func newWebhook(urls ...string) *notify.Webhook {
+	return notify.NewWebhook(notify.Config{
+		URLs:       urls,
+		Secret:     "s3cret",
+		Backoff:    time.Millisecond,
+		MaxBackoff: 2 * time.Millisecond,
+	})
+}
+
+var notification = domain.Notification{
+	ID:         "42",
+	Kind:       domain.NotifyReviewerReassigned,
+	PRID:       "pr-1",
+	UserID:     "u1",
+	ReplacedBy: "u2",
+	At:         time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestWebhookSignsPayload(t *testing.T) {
+	rv := &receiver{}
+	srv := httptest.NewServer(rv)
+	defer srv.Close()
+
+	if err := newWebhook(srv.URL).Notify(context.Background(), notification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	reqs := rv.got()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d requests, want 1", len(reqs))
+	}
+	req := reqs[0]
+
+	if got, want := req.header.Get(notify.SignatureHeader), notify.Sign("s3cret", req.body); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+	if got := req.header.Get(notify.EventHeader); got != string(domain.NotifyReviewerReassigned) {
+		t.Errorf("%s = %q", notify.EventHeader, got)
+	}
+	if got := req.header.Get(notify.EventIDHeader); got != "42" {
+		t.Errorf("%s = %q, want 42", notify.EventIDHeader, got)
+	}
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	var payload domain.Notification
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if payload.ID != "42" || payload.PRID != "pr-1" || payload.UserID != "u1" ||
+		payload.ReplacedBy != "u2" || !payload.At.Equal(notification.At) {
+		t.Errorf("payload = %+v", payload)
+	}
+}
+
+func TestWebhookWithoutSecretIsUnsigned(t *testing.T) {
+	rv := &receiver{}
+	srv := httptest.NewServer(rv)
+	defer srv.Close()
+
+	w := notify.NewWebhook(notify.Config{URLs: []string{srv.URL}})
+	if err := w.Notify(context.Background(), notification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if got := rv.got()[0].header.Get(notify.SignatureHeader); got != "" {
+		t.Errorf("signature = %q, want none", got)
+	}
+}
+
+func TestWebhookRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		wantReqs int
+		wantErr  bool
+		// отказ окончательный — relay не должен повторять
+		wantUndeliverable bool
+	}{
+		{"5xx then success", []int{500, 503}, 3, false, false},
+		{"429 then success", []int{429}, 2, false, false},
+		{"gives up after max attempts", []int{500, 500, 500, 500}, 3, true, false},
+		{"4xx is not retried", []int{400}, 1, true, true},
+		{"410 is not retried", []int{410}, 1, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rv := &receiver{statuses: tt.statuses}
+			srv := httptest.NewServer(rv)
+			defer srv.Close()
+
+			err := newWebhook(srv.URL).Notify(context.Background(), notification)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, domain.ErrUndeliverable); got != tt.wantUndeliverable {
+				t.Errorf("err = %v, undeliverable %v, want %v", err, got, tt.wantUndeliverable)
+			}
+			reqs := rv.got()
+			if len(reqs) != tt.wantReqs {
+				t.Fatalf("got %d requests, want %d", len(reqs), tt.wantReqs)
+			}
+			// повтор — тот же запрос с той же подписью и id
+			for _, r := range reqs[1:] {
+				if string(r.body) != string(reqs[0].body) ||
+					r.header.Get(notify.SignatureHeader) != reqs[0].header.Get(notify.SignatureHeader) {
+					t.Errorf("retry differs from the first attempt")
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookReportsEveryFailedReceiver(t *testing.T) {
+	ok := &receiver{}
+	okSrv := httptest.NewServer(ok)
+	defer okSrv.Close()
+	bad := &receiver{statuses: []int{404}}
+	badSrv := httptest.NewServer(bad)
+	defer badSrv.Close()
+
+	err := newWebhook(badSrv.URL, okSrv.URL).Notify(context.Background(), notification)
+	if err == nil {
+		t.Fatal("want error for the failed receiver")
+	}
+	if !errors.Is(err, domain.ErrUndeliverable) {
+		t.Errorf("err = %v, want ErrUndeliverable", err)
+	}
+	if len(ok.got()) != 1 {
+		t.Errorf("healthy receiver got %d requests, want 1", len(ok.got()))
+	}
+}
+
+// Если хоть один получатель может принять уведомление позже,
+// отказ не окончательный, даже когда другой ответил 404.
+func TestWebhookRetryableWinsOverRejected(t *testing.T) {
+	gone := &receiver{statuses: []int{404}}
+	goneSrv := httptest.NewServer(gone)
+	defer goneSrv.Close()
+	down := &receiver{statuses: []int{503, 503, 503}}
+	downSrv := httptest.NewServer(down)
+	defer downSrv.Close()
+
+	err := newWebhook(goneSrv.URL, downSrv.URL).Notify(context.Background(), notification)
+	if err == nil || errors.Is(err, domain.ErrUndeliverable) {
+		t.Errorf("err = %v, want a retryable error", err)
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"pr-reviewer-service/internal/domain"
+)
+
+type AssignmentEventRepository interface {
+	Add(ctx context.Context, e domain.AssignmentEvent) error
+	ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error)
+}
+
+type assignmentEventRepo struct {
+	db DB
+}
+
+func NewAssignmentEventRepository(db DB) AssignmentEventRepository {
+	return &assignmentEventRepo{db: db}
+}
+
+func (r *assignmentEventRepo) Add(ctx context.Context, e domain.AssignmentEvent) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO assignment_events (pull_request_id, user_id, action, reason, detail, actor)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		e.PRID, e.UserID, e.Action, e.Reason, e.Detail, e.Actor,
+	)
+	return err
+}
+
+func (r *assignmentEventRepo) ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	rows, err := conn(ctx, r.db).Query(ctx,
+		`SELECT event_id, pull_request_id, user_id, action, reason, detail, actor, created_at
+		   FROM assignment_events
+		  WHERE pull_request_id=$1
+		  ORDER BY event_id`,
+		prID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.AssignmentEvent
+	for rows.Next() {
+		var e domain.AssignmentEvent
+		if err := rows.Scan(&e.ID, &e.PRID, &e.UserID, &e.Action, &e.Reason,
+			&e.Detail, &e.Actor, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"pr-reviewer-service/internal/domain"
+	"time"
+)
+
+type EscalationRepository interface {
+	// Overdue — назначения без решения на OPEN PR, которые на момент now
+	// превысили review_sla_hours команды автора PR.
+	Overdue(ctx context.Context, now time.Time) ([]domain.OverdueReview, error)
+	Record(ctx context.Context, e domain.Escalation) (domain.Escalation, error)
+	ListByPR(ctx context.Context, prID string) ([]domain.Escalation, error)
+}
+
+type escalationRepo struct {
+	db DB
+}
+
+func NewEscalationRepository(db DB) EscalationRepository {
+	return &escalationRepo{db: db}
+}
+
+func (r *escalationRepo) Overdue(ctx context.Context, now time.Time) ([]domain.OverdueReview, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		SELECT prr.pull_request_id, prr.user_id, u.team_name, prr.assigned_at,
+		       ts.review_sla_hours, ts.escalation_hours,
+		       EXISTS (
+		           SELECT 1 FROM review_escalations e
+		            WHERE e.pull_request_id = prr.pull_request_id
+		              AND e.user_id = prr.user_id
+		              AND e.assigned_at = prr.assigned_at
+		              AND e.kind = 'reminder'
+		       )
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = pr.author_id
+		JOIN team_settings ts ON ts.team_name = u.team_name
+		WHERE pr.status = 'OPEN'
+		  AND prr.first_decided_at IS NULL
+		  AND ts.review_sla_hours > 0
+		  AND prr.assigned_at <= $1 - make_interval(hours => ts.review_sla_hours)
+		ORDER BY prr.assigned_at
+	`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.OverdueReview
+	for rows.Next() {
+		var o domain.OverdueReview
+		var slaHours, escalationHours int
+		if err := rows.Scan(&o.PRID, &o.ReviewerID, &o.TeamName, &o.AssignedAt,
+			&slaHours, &escalationHours, &o.Reminded); err != nil {
+			return nil, err
+		}
+		o.SLA = time.Duration(slaHours) * time.Hour
+		o.EscalateAfter = time.Duration(escalationHours) * time.Hour
+		result = append(result, o)
+	}
+	return result, rows.Err()
+}
+
+func (r *escalationRepo) Record(ctx context.Context, e domain.Escalation) (domain.Escalation, error) {
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`INSERT INTO review_escalations (pull_request_id, user_id, kind, assigned_at, replaced_by, reason)
+		 VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		 RETURNING escalation_id, created_at`,
+		e.PRID, e.ReviewerID, e.Kind, e.AssignedAt, e.ReplacedBy, e.Reason,
+	).Scan(&e.ID, &e.CreatedAt)
+	return e, err
+}
+
+func (r *escalationRepo) ListByPR(ctx context.Context, prID string) ([]domain.Escalation, error) {
+	rows, err := conn(ctx, r.db).Query(ctx,
+		`SELECT escalation_id, pull_request_id, user_id, kind, assigned_at,
+		        COALESCE(replaced_by, ''), reason, created_at
+		   FROM review_escalations
+		  WHERE pull_request_id=$1
+		  ORDER BY created_at, escalation_id`,
+		prID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.Escalation
+	for rows.Next() {
+		var e domain.Escalation
+		if err := rows.Scan(&e.ID, &e.PRID, &e.ReviewerID, &e.Kind, &e.AssignedAt,
+			&e.ReplacedBy, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type assignmentEventRepo struct {
+	s *Store
+}
+
+func NewAssignmentEventRepository(s *Store) repository.AssignmentEventRepository {
+	return &assignmentEventRepo{s: s}
+}
+
+func (r *assignmentEventRepo) Add(ctx context.Context, e domain.AssignmentEvent) error {
+	return r.s.do(ctx, func(st *state) error {
+		e.ID = st.nextID()
+		e.CreatedAt = now()
+		st.events = append(st.events, e)
+		return nil
+	})
+}
+
+func (r *assignmentEventRepo) ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	var res []domain.AssignmentEvent
+	err := r.s.do(ctx, func(st *state) error {
+		for _, e := range st.events {
+			if e.PRID == prID {
+				res = append(res, e)
+			}
+		}
+		return nil
+	})
+	return res, err
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type escalationRepo struct {
+	s *Store
+}
+
+func NewEscalationRepository(s *Store) repository.EscalationRepository {
+	return &escalationRepo{s: s}
+}
+
+func (r *escalationRepo) Overdue(ctx context.Context, at time.Time) ([]domain.OverdueReview, error) {
+	var res []domain.OverdueReview
+	err := r.s.do(ctx, func(st *state) error {
+		st.eachPR(func(pr pullRequest) {
+			if pr.Status != domain.PRStatusOpen {
+				return
+			}
+			author, ok := st.users[pr.AuthorID]
+			if !ok {
+				return
+			}
+			settings := st.teams[author.TeamName].settings
+			if settings == nil || settings.ReviewSLAHours <= 0 {
+				return
+			}
+			sla := time.Duration(settings.ReviewSLAHours) * time.Hour
+
+			for _, rv := range pr.reviewers {
+				if rv.FirstDecidedAt != nil || rv.AssignedAt.After(at.Add(-sla)) {
+					continue
+				}
+				res = append(res, domain.OverdueReview{
+					PRID:          pr.ID,
+					ReviewerID:    rv.ReviewerID,
+					TeamName:      author.TeamName,
+					AssignedAt:    rv.AssignedAt,
+					SLA:           sla,
+					EscalateAfter: time.Duration(settings.EscalationHours) * time.Hour,
+					Reminded: slices.ContainsFunc(st.escalations, func(e domain.Escalation) bool {
+						return e.PRID == pr.ID && e.ReviewerID == rv.ReviewerID &&
+							e.AssignedAt.Equal(rv.AssignedAt) && e.Kind == domain.EscalationReminder
+					}),
+				})
+			}
+		})
+		return nil
+	})
+	slices.SortStableFunc(res, func(a, b domain.OverdueReview) int {
+		return a.AssignedAt.Compare(b.AssignedAt)
+	})
+	return res, err
+}
+
+func (r *escalationRepo) Record(ctx context.Context, e domain.Escalation) (domain.Escalation, error) {
+	err := r.s.do(ctx, func(st *state) error {
+		e.ID = st.nextID()
+		e.CreatedAt = now()
+		st.escalations = append(st.escalations, e)
+		return nil
+	})
+	return e, err
+}
+
+func (r *escalationRepo) ListByPR(ctx context.Context, prID string) ([]domain.Escalation, error) {
+	var res []domain.Escalation
+	err := r.s.do(ctx, func(st *state) error {
+		for _, e := range st.escalations {
+			if e.PRID == prID {
+				res = append(res, e)
+			}
+		}
+		return nil
+	})
+	return res, err
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type outboxRepo struct {
+	s *Store
+}
+
+func NewOutboxRepository(s *Store) repository.OutboxRepository {
+	return &outboxRepo{s: s}
+}
+
+func (r *outboxRepo) Add(ctx context.Context, n domain.Notification) error {
+	if n.At.IsZero() {
+		n.At = now()
+	}
+	return r.s.do(ctx, func(st *state) error {
+		st.outbox = append(st.outbox, outboxRow{
+			id:            st.nextID(),
+			n:             n,
+			nextAttemptAt: now(),
+		})
+		return nil
+	})
+}
+
+func (r *outboxRepo) LeasePending(ctx context.Context, at, until time.Time, limit int) ([]domain.OutboxEvent, error) {
+	var res []domain.OutboxEvent
+	err := r.s.do(ctx, func(st *state) error {
+		for i, row := range st.outbox {
+			if len(res) == limit {
+				break
+			}
+			if row.sentAt != nil || row.deadAt != nil || row.nextAttemptAt.After(at) {
+				continue
+			}
+			st.outbox[i].nextAttemptAt = until
+			e := domain.OutboxEvent{Notification: row.n, Attempts: row.attempts}
+			e.ID = strconv.FormatInt(row.id, 10)
+			res = append(res, e)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *outboxRepo) MarkSent(ctx context.Context, id string) error {
+	return r.update(ctx, id, func(row *outboxRow) {
+		at := now()
+		row.sentAt = &at
+		row.lastError = ""
+	})
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, id string, reason string, next time.Time) error {
+	return r.update(ctx, id, func(row *outboxRow) {
+		row.attempts++
+		row.lastError = reason
+		row.nextAttemptAt = next
+	})
+}
+
+func (r *outboxRepo) MarkDead(ctx context.Context, id string, reason string) error {
+	return r.update(ctx, id, func(row *outboxRow) {
+		at := now()
+		row.attempts++
+		row.lastError = reason
+		row.deadAt = &at
+	})
+}
+
+func (r *outboxRepo) update(ctx context.Context, id string, fn func(row *outboxRow)) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	return r.s.do(ctx, func(st *state) error {
+		for i := range st.outbox {
+			if st.outbox[i].id == eventID {
+				fn(&st.outbox[i])
+				return nil
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,378 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type prRepo struct {
+	s *Store
+}
+
+func NewPRRepository(s *Store) repository.PRRepository {
+	return &prRepo{s: s}
+}
+
+// inPeriod — t попадает в полуинтервал фильтра [From, To).
+func inPeriod(filter domain.StatsFilter, t time.Time) bool {
+	return (filter.From == nil || !t.Before(*filter.From)) &&
+		(filter.To == nil || t.Before(*filter.To))
+}
+
+// eachPR обходит PR в порядке создания.
+func (st *state) eachPR(fn func(pr pullRequest)) {
+	for _, id := range st.prOrder {
+		fn(st.prs[id])
+	}
+}
+
+// updateReviewer применяет fn к назначению userID на prID; false — его нет.
+func (st *state) updateReviewer(prID, userID string, fn func(rv *reviewer)) bool {
+	pr, ok := st.prs[prID]
+	if !ok {
+		return false
+	}
+	i := slices.IndexFunc(pr.reviewers, func(rv reviewer) bool { return rv.ReviewerID == userID })
+	if i < 0 {
+		return false
+	}
+	pr.reviewers = slices.Clone(pr.reviewers)
+	fn(&pr.reviewers[i])
+	st.prs[prID] = pr
+	return true
+}
+
+func (r *prRepo) Create(ctx context.Context, pr domain.PullRequest) error {
+	return r.s.do(ctx, func(st *state) error {
+		if _, ok := st.prs[pr.ID]; ok {
+			return domain.ErrPRExists
+		}
+		if _, ok := st.users[pr.AuthorID]; !ok {
+			return fmt.Errorf("user %q does not exist", pr.AuthorID)
+		}
+
+		row := pullRequest{PullRequest: domain.PullRequest{
+			ID:        pr.ID,
+			Name:      pr.Name,
+			AuthorID:  pr.AuthorID,
+			Status:    pr.Status,
+			CreatedAt: pr.CreatedAt,
+		}}
+		for _, path := range pr.Files {
+			if !slices.Contains(row.Files, path) {
+				row.Files = append(row.Files, path)
+			}
+		}
+		slices.Sort(row.Files)
+
+		st.prs[pr.ID] = row
+		st.prOrder = append(st.prOrder, pr.ID)
+		return nil
+	})
+}
+
+func (r *prRepo) AddReviewer(ctx context.Context, prID string, rv domain.Review) error {
+	return r.s.do(ctx, func(st *state) error {
+		pr, ok := st.prs[prID]
+		if !ok {
+			return fmt.Errorf("pull request %q does not exist", prID)
+		}
+		if _, ok := st.users[rv.ReviewerID]; !ok {
+			return fmt.Errorf("user %q does not exist", rv.ReviewerID)
+		}
+		if slices.ContainsFunc(pr.reviewers, func(x reviewer) bool { return x.ReviewerID == rv.ReviewerID }) {
+			return nil
+		}
+		pr.reviewers = append(slices.Clone(pr.reviewers), reviewer{
+			Review:     domain.Review{ReviewerID: rv.ReviewerID, FallbackTeam: rv.FallbackTeam},
+			AssignedAt: now(),
+		})
+		st.prs[prID] = pr
+		return nil
+	})
+}
+
+func (r *prRepo) Get(ctx context.Context, prID string) (domain.PullRequest, error) {
+	var res domain.PullRequest
+	err := r.s.do(ctx, func(st *state) error {
+		pr, ok := st.prs[prID]
+		if !ok {
+			return domain.ErrPRNotFound
+		}
+		res = pr.PullRequest
+		res.Files = slices.Clone(pr.Files)
+		for _, rv := range pr.reviewers {
+			res.Reviewers = append(res.Reviewers, rv.ReviewerID)
+			res.Reviews = append(res.Reviews, rv.Review)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) Merge(ctx context.Context, prID string) error {
+	return r.s.do(ctx, func(st *state) error {
+		pr, ok := st.prs[prID]
+		if !ok {
+			return domain.ErrPRNotFound
+		}
+		at := now()
+		pr.Status = domain.PRStatusMerged
+		pr.MergedAt = &at
+		st.prs[prID] = pr
+		return nil
+	})
+}
+
+func (r *prRepo) SetStatus(ctx context.Context, prID string, status domain.PRStatus) error {
+	return r.s.do(ctx, func(st *state) error {
+		pr, ok := st.prs[prID]
+		if !ok {
+			return domain.ErrPRNotFound
+		}
+		pr.Status = status
+		pr.ClosedAt = nil
+		if status == domain.PRStatusClosed {
+			at := now()
+			pr.ClosedAt = &at
+		}
+		st.prs[prID] = pr
+		return nil
+	})
+}
+
+func (r *prRepo) ReplaceReviewer(ctx context.Context, prID, oldUser string, newReviewer domain.Review) error {
+	return r.s.do(ctx, func(st *state) error {
+		ok := st.updateReviewer(prID, oldUser, func(rv *reviewer) {
+			*rv = reviewer{
+				Review:     domain.Review{ReviewerID: newReviewer.ReviewerID, FallbackTeam: newReviewer.FallbackTeam},
+				AssignedAt: now(),
+			}
+		})
+		if !ok {
+			return repository.ErrReviewerNotFound
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) GetForReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error) {
+	var res []domain.PullRequestShort
+	err := r.s.do(ctx, func(st *state) error {
+		st.eachPR(func(pr pullRequest) {
+			if slices.ContainsFunc(pr.reviewers, func(rv reviewer) bool { return rv.ReviewerID == reviewerID }) {
+				res = append(res, domain.PullRequestShort{
+					ID:       pr.ID,
+					Name:     pr.Name,
+					AuthorID: pr.AuthorID,
+					Status:   pr.Status,
+				})
+			}
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) Stats(ctx context.Context, filter domain.StatsFilter) (domain.Stats, error) {
+	stats := domain.Stats{PRStatus: map[domain.PRStatus]int{}}
+	err := r.s.do(ctx, func(st *state) error {
+		byUser := map[string]*domain.UserStats{}
+		row := func(userID string) *domain.UserStats {
+			u, ok := st.users[userID]
+			if !ok || filter.Team != "" && u.TeamName != filter.Team {
+				return nil
+			}
+			if byUser[userID] == nil {
+				byUser[userID] = &domain.UserStats{UserID: u.ID, TeamName: u.TeamName}
+			}
+			return byUser[userID]
+		}
+
+		st.eachPR(func(pr pullRequest) {
+			if !inPeriod(filter, pr.CreatedAt) {
+				return
+			}
+			for _, rv := range pr.reviewers {
+				if us := row(rv.ReviewerID); us != nil {
+					us.Assignments++
+					switch pr.Status {
+					case domain.PRStatusOpen:
+						us.OpenAssignments++
+					case domain.PRStatusMerged:
+						us.MergedAssignments++
+					}
+				}
+			}
+			if us := row(pr.AuthorID); us != nil {
+				us.AuthoredPRs++
+				stats.PRStatus[pr.Status]++
+			}
+		})
+
+		for _, us := range byUser {
+			stats.Users = append(stats.Users, *us)
+		}
+		slices.SortFunc(stats.Users, func(a, b domain.UserStats) int {
+			return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.UserID, b.UserID))
+		})
+		return nil
+	})
+	return stats, err
+}
+
+func (r *prRepo) Turnaround(ctx context.Context, filter domain.StatsFilter) (domain.TurnaroundSamples, error) {
+	var res domain.TurnaroundSamples
+	err := r.s.do(ctx, func(st *state) error {
+		sample := func(userID string, d time.Duration) (domain.DurationSample, bool) {
+			u, ok := st.users[userID]
+			if !ok || filter.Team != "" && u.TeamName != filter.Team {
+				return domain.DurationSample{}, false
+			}
+			return domain.DurationSample{UserID: u.ID, TeamName: u.TeamName, Duration: d}, true
+		}
+
+		st.eachPR(func(pr pullRequest) {
+			if !inPeriod(filter, pr.CreatedAt) {
+				return
+			}
+			if pr.Status == domain.PRStatusMerged && pr.MergedAt != nil {
+				merge := pr.MergedAt.Sub(pr.CreatedAt)
+				if x, ok := sample(pr.AuthorID, merge); ok {
+					res.Merges = append(res.Merges, x)
+				}
+				for _, rv := range pr.reviewers {
+					if x, ok := sample(rv.ReviewerID, merge); ok {
+						res.ReviewerMerges = append(res.ReviewerMerges, x)
+					}
+				}
+			}
+			for _, rv := range pr.reviewers {
+				if rv.FirstDecidedAt == nil {
+					continue
+				}
+				if x, ok := sample(rv.ReviewerID, rv.FirstDecidedAt.Sub(rv.AssignedAt)); ok {
+					res.Reviews = append(res.Reviews, x)
+				}
+			}
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *prRepo) AssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.AssignmentCount, error) {
+	var res []domain.AssignmentCount
+	err := r.s.do(ctx, func(st *state) error {
+		weekly := map[string]map[time.Time]int{}
+		st.eachPR(func(pr pullRequest) {
+			for _, rv := range pr.reviewers {
+				if !inPeriod(filter, rv.AssignedAt) {
+					continue
+				}
+				if weekly[rv.ReviewerID] == nil {
+					weekly[rv.ReviewerID] = map[time.Time]int{}
+				}
+				weekly[rv.ReviewerID][domain.WeekStart(rv.AssignedAt)]++
+			}
+		})
+
+		for _, u := range st.selectUsers(func(u domain.User) bool {
+			return filter.Team == "" || u.TeamName == filter.Team
+		}) {
+			weeks := weekly[u.ID]
+			if len(weeks) == 0 {
+				if u.IsActive {
+					res = append(res, domain.AssignmentCount{UserID: u.ID, TeamName: u.TeamName})
+				}
+				continue
+			}
+			for week, n := range weeks {
+				res = append(res, domain.AssignmentCount{UserID: u.ID, TeamName: u.TeamName, Week: week, Count: n})
+			}
+		}
+		return nil
+	})
+	slices.SortFunc(res, func(a, b domain.AssignmentCount) int {
+		return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.UserID, b.UserID), a.Week.Compare(b.Week))
+	})
+	return res, err
+}
+
+func (r *prRepo) OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	err := r.s.do(ctx, func(st *state) error {
+		st.eachPR(func(pr pullRequest) {
+			if pr.Status != domain.PRStatusOpen {
+				return
+			}
+			for _, rv := range pr.reviewers {
+				if slices.Contains(userIDs, rv.ReviewerID) {
+					counts[rv.ReviewerID]++
+				}
+			}
+		})
+		return nil
+	})
+	return counts, err
+}
+
+func (r *prRepo) GetOpenByReviewers(ctx context.Context, userIDs []string) ([]string, error) {
+	var prIDs []string
+	err := r.s.do(ctx, func(st *state) error {
+		st.eachPR(func(pr pullRequest) {
+			if pr.Status == domain.PRStatusOpen && slices.ContainsFunc(pr.reviewers, func(rv reviewer) bool {
+				return slices.Contains(userIDs, rv.ReviewerID)
+			}) {
+				prIDs = append(prIDs, pr.ID)
+			}
+		})
+		return nil
+	})
+	return prIDs, err
+}
+
+func (r *prRepo) RemoveReviewer(ctx context.Context, prID, userID string) error {
+	return r.s.do(ctx, func(st *state) error {
+		if pr, ok := st.prs[prID]; ok {
+			pr.reviewers = slices.DeleteFunc(slices.Clone(pr.reviewers), func(rv reviewer) bool {
+				return rv.ReviewerID == userID
+			})
+			st.prs[prID] = pr
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) SetDecision(ctx context.Context, prID, userID string, decision domain.ReviewDecision) error {
+	return r.s.do(ctx, func(st *state) error {
+		ok := st.updateReviewer(prID, userID, func(rv *reviewer) {
+			at := now()
+			rv.Decision = decision
+			rv.DecidedAt = &at
+			if rv.FirstDecidedAt == nil {
+				rv.FirstDecidedAt = &at
+			}
+		})
+		if !ok {
+			return domain.ErrNotAssigned
+		}
+		return nil
+	})
+}
+
+func (r *prRepo) RemoveReviewers(ctx context.Context, prID string) error {
+	return r.s.do(ctx, func(st *state) error {
+		if pr, ok := st.prs[prID]; ok {
+			pr.reviewers = nil
+			st.prs[prID] = pr
+		}
+		return nil
+	})
+}
@@ -0,0 +1,157 @@
+// Package memory — реализации репозиториев в памяти процесса: для локального
+// запуска без базы (-storage=memory) и для тестов сервисов.
+//
+// Все репозитории одного Store делят общее состояние под одним мьютексом.
+// Транзакция держит мьютекс до конца и при ошибке восстанавливает снимок
+// состояния, поэтому транзакции выполняются строго по очереди.
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// Store — общее состояние репозиториев; реализует repository.Transactor.
+type Store struct {
+	mu sync.Mutex
+	st *state
+}
+
+func NewStore() *Store {
+	return &Store{st: &state{
+		teams:  map[string]team{},
+		users:  map[string]domain.User{},
+		logins: map[loginKey]string{},
+		prs:    map[string]pullRequest{},
+
+		roundRobin: map[string]int{},
+	}}
+}
+
+// Значения в state не изменяются через указатели и общие слайсы:
+// запись заменяет значение целиком, иначе снимок clone испортится.
+type state struct {
+	teams     map[string]team
+	users     map[string]domain.User
+	userOrder []string
+	absences  []domain.Absence
+	logins    map[loginKey]string
+
+	prs     map[string]pullRequest
+	prOrder []string
+
+	escalations []domain.Escalation
+	outbox      []outboxRow
+	events      []domain.AssignmentEvent
+
+	// roundRobin — позиции очередей round-robin: queue -> next_index
+	roundRobin map[string]int
+
+	// lastID — общий счётчик для BIGSERIAL-идентификаторов
+	lastID int64
+}
+
+type team struct {
+	settings   *domain.TeamSettings
+	codeowners *domain.TeamCodeowners
+}
+
+type loginKey struct {
+	provider domain.Provider
+	login    string
+}
+
+// reviewer — строка pull_request_reviewers.
+type reviewer struct {
+	domain.Review
+	AssignedAt     time.Time
+	FirstDecidedAt *time.Time
+}
+
+// pullRequest хранит ревьюверов в reviewers; Reviewers и Reviews
+// заполняются только при выдаче.
+type pullRequest struct {
+	domain.PullRequest
+	reviewers []reviewer
+}
+
+type outboxRow struct {
+	id            int64
+	n             domain.Notification
+	attempts      int
+	nextAttemptAt time.Time
+	lastError     string
+	sentAt        *time.Time
+	deadAt        *time.Time
+}
+
+func (s *state) clone() *state {
+	c := *s
+	c.teams = maps.Clone(s.teams)
+	c.users = maps.Clone(s.users)
+	c.userOrder = slices.Clone(s.userOrder)
+	c.absences = slices.Clone(s.absences)
+	c.logins = maps.Clone(s.logins)
+
+	c.prs = make(map[string]pullRequest, len(s.prs))
+	for id, pr := range s.prs {
+		pr.reviewers = slices.Clone(pr.reviewers)
+		pr.Files = slices.Clone(pr.Files)
+		c.prs[id] = pr
+	}
+	c.prOrder = slices.Clone(s.prOrder)
+
+	c.escalations = slices.Clone(s.escalations)
+	c.outbox = slices.Clone(s.outbox)
+	c.events = slices.Clone(s.events)
+
+	c.roundRobin = maps.Clone(s.roundRobin)
+	return &c
+}
+
+func (s *state) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+type txKey struct{}
+
+// WithinTx выполняет fn под мьютексом Store; ошибка из fn возвращает
+// состояние к моменту начала транзакции. Вложенный вызов присоединяется
+// к внешней транзакции.
+func (s *Store) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) == s {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.st.clone()
+	if err := fn(context.WithValue(ctx, txKey{}, s)); err != nil {
+		s.st = snapshot
+		return err
+	}
+	return nil
+}
+
+// do выполняет fn над состоянием: внутри транзакции — сразу,
+// вне её — под мьютексом.
+func (s *Store) do(ctx context.Context, fn func(st *state) error) error {
+	if ctx.Value(txKey{}) == s {
+		return fn(s.st)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.st)
+}
+
+// now — время записи, как NOW() в Postgres.
+func now() time.Time {
+	return time.Now().UTC()
+}
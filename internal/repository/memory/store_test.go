@@ -0,0 +1,23 @@
+package memory_test
+
+import (
+	"testing"
+
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/repository/repositorytest"
+)
+
+func TestRepositories(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		s := memory.NewStore()
+		return repositorytest.Repositories{
+			Teams:       memory.NewTeamRepository(s),
+			Users:       memory.NewUserRepository(s),
+			PRs:         memory.NewPRRepository(s),
+			Escalations: memory.NewEscalationRepository(s),
+			Outbox:      memory.NewOutboxRepository(s),
+			Events:      memory.NewAssignmentEventRepository(s),
+			Tx:          s,
+		}
+	})
+}
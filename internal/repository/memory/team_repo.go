@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type teamRepo struct {
+	s *Store
+}
+
+func NewTeamRepository(s *Store) repository.TeamRepository {
+	return &teamRepo{s: s}
+}
+
+func (r *teamRepo) Create(ctx context.Context, name string) error {
+	return r.s.do(ctx, func(st *state) error {
+		if _, ok := st.teams[name]; ok {
+			return repository.ErrTeamExists
+		}
+		st.teams[name] = team{}
+		return nil
+	})
+}
+
+func (r *teamRepo) Get(ctx context.Context, teamName string) (*domain.Team, error) {
+	var res *domain.Team
+	err := r.s.do(ctx, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return repository.ErrTeamNotFound
+		}
+		res = &domain.Team{Name: teamName}
+		for _, id := range st.userOrder {
+			if u := st.users[id]; u.TeamName == teamName {
+				res.Members = append(res.Members, domain.TeamMember{
+					ID:       u.ID,
+					Username: u.Username,
+					IsActive: u.IsActive,
+				})
+			}
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *teamRepo) AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) error {
+	return r.s.do(ctx, func(st *state) error {
+		if _, ok := st.teams[teamName]; !ok {
+			return fmt.Errorf("team %q does not exist", teamName)
+		}
+		for _, m := range members {
+			if _, ok := st.users[m.ID]; ok {
+				return fmt.Errorf("user %q already exists", m.ID)
+			}
+			st.addUser(domain.User{ID: m.ID, Username: m.Username, TeamName: teamName, IsActive: m.IsActive})
+		}
+		return nil
+	})
+}
+
+func (r *teamRepo) GetSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	var res domain.TeamSettings
+	err := r.s.do(ctx, func(st *state) error {
+		t, ok := st.teams[teamName]
+		if !ok {
+			return repository.ErrTeamNotFound
+		}
+		if t.settings == nil {
+			return repository.ErrNoSettings
+		}
+		res = *t.settings
+		res.FallbackTeams = slices.Clone(res.FallbackTeams)
+		return nil
+	})
+	return res, err
+}
+
+func (r *teamRepo) SaveSettings(ctx context.Context, settings domain.TeamSettings) error {
+	return r.s.do(ctx, func(st *state) error {
+		t, ok := st.teams[settings.TeamName]
+		if !ok {
+			return repository.ErrTeamNotFound
+		}
+		settings.FallbackTeams = slices.Clone(settings.FallbackTeams)
+		if settings.FallbackTeams == nil {
+			settings.FallbackTeams = []string{}
+		}
+		t.settings = &settings
+		st.teams[settings.TeamName] = t
+		return nil
+	})
+}
+
+func (r *teamRepo) GetCodeowners(ctx context.Context, teamName string) (domain.TeamCodeowners, error) {
+	res := domain.TeamCodeowners{TeamName: teamName}
+	err := r.s.do(ctx, func(st *state) error {
+		t, ok := st.teams[teamName]
+		if !ok {
+			return repository.ErrTeamNotFound
+		}
+		if t.codeowners != nil {
+			res = *t.codeowners
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (r *teamRepo) SaveCodeowners(ctx context.Context, co domain.TeamCodeowners) error {
+	return r.s.do(ctx, func(st *state) error {
+		t, ok := st.teams[co.TeamName]
+		if !ok {
+			return repository.ErrTeamNotFound
+		}
+		t.codeowners = &co
+		st.teams[co.TeamName] = t
+		return nil
+	})
+}
+
+func (r *teamRepo) AdvanceRoundRobin(ctx context.Context, queue string, step int) (int, error) {
+	var prev int
+	err := r.s.do(ctx, func(st *state) error {
+		prev = st.roundRobin[queue]
+		st.roundRobin[queue] = prev + step
+		return nil
+	})
+	return prev, err
+}
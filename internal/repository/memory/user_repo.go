@@ -0,0 +1,212 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type userRepo struct {
+	s *Store
+}
+
+func NewUserRepository(s *Store) repository.UserRepository {
+	return &userRepo{s: s}
+}
+
+// addUser добавляет или заменяет пользователя, сохраняя порядок добавления.
+func (st *state) addUser(u domain.User) {
+	if _, ok := st.users[u.ID]; !ok {
+		st.userOrder = append(st.userOrder, u.ID)
+	}
+	st.users[u.ID] = u
+}
+
+// absent — у пользователя есть отсутствие, покрывающее at.
+func (st *state) absent(userID string, at time.Time) bool {
+	for _, a := range st.absences {
+		if a.UserID == userID && !a.StartsAt.After(at) && a.EndsAt.After(at) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectUsers — пользователи, подходящие под match, в порядке добавления.
+func (st *state) selectUsers(match func(u domain.User) bool) []domain.User {
+	var result []domain.User
+	for _, id := range st.userOrder {
+		if u := st.users[id]; match(u) {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+func (r *userRepo) Create(ctx context.Context, user domain.User) error {
+	return r.s.do(ctx, func(st *state) error {
+		if _, ok := st.teams[user.TeamName]; !ok {
+			return fmt.Errorf("team %q does not exist", user.TeamName)
+		}
+		st.addUser(user)
+		return nil
+	})
+}
+
+func (r *userRepo) SetActive(ctx context.Context, userID string, active bool) error {
+	return r.s.do(ctx, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return domain.ErrUserNotFound
+		}
+		u.IsActive = active
+		st.users[userID] = u
+		return nil
+	})
+}
+
+func (r *userRepo) Get(ctx context.Context, userID string) (*domain.User, error) {
+	var res *domain.User
+	err := r.s.do(ctx, func(st *state) error {
+		u, ok := st.users[userID]
+		if !ok {
+			return domain.ErrUserNotFound
+		}
+		res = &u
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) GetActiveUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
+	var res []domain.User
+	err := r.s.do(ctx, func(st *state) error {
+		res = st.selectUsers(func(u domain.User) bool {
+			return u.TeamName == teamName && u.IsActive
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) DeactivateMany(ctx context.Context, ids []string) error {
+	return r.s.do(ctx, func(st *state) error {
+		for _, id := range ids {
+			if u, ok := st.users[id]; ok {
+				u.IsActive = false
+				st.users[id] = u
+			}
+		}
+		return nil
+	})
+}
+
+func (r *userRepo) GetAvailableUsersByTeam(ctx context.Context, teamName string, at time.Time) ([]domain.User, error) {
+	var res []domain.User
+	err := r.s.do(ctx, func(st *state) error {
+		res = st.selectUsers(func(u domain.User) bool {
+			return u.TeamName == teamName && u.IsActive && !st.absent(u.ID, at)
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) GetAvailableUsers(ctx context.Context, at time.Time) ([]domain.User, error) {
+	var res []domain.User
+	err := r.s.do(ctx, func(st *state) error {
+		res = st.selectUsers(func(u domain.User) bool {
+			return u.IsActive && !st.absent(u.ID, at)
+		})
+		return nil
+	})
+	return res, err
+}
+
+func (r *userRepo) AddAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := r.s.do(ctx, func(st *state) error {
+		if _, ok := st.users[a.UserID]; !ok {
+			return fmt.Errorf("user %q does not exist", a.UserID)
+		}
+		a.ID = st.nextID()
+		st.absences = append(st.absences, a)
+		return nil
+	})
+	return a, err
+}
+
+func (r *userRepo) UpsertAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := r.s.do(ctx, func(st *state) error {
+		if _, ok := st.users[a.UserID]; !ok {
+			return fmt.Errorf("user %q does not exist", a.UserID)
+		}
+		i := slices.IndexFunc(st.absences, func(old domain.Absence) bool {
+			return old.UserID == a.UserID && old.UID == a.UID
+		})
+		if i < 0 {
+			a.ID = st.nextID()
+			st.absences = append(st.absences, a)
+			return nil
+		}
+		a.ID = st.absences[i].ID
+		st.absences[i] = a
+		return nil
+	})
+	return a, err
+}
+
+func (r *userRepo) ListAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	var res []domain.Absence
+	err := r.s.do(ctx, func(st *state) error {
+		for _, a := range st.absences {
+			if a.UserID == userID {
+				res = append(res, a)
+			}
+		}
+		return nil
+	})
+	slices.SortStableFunc(res, func(a, b domain.Absence) int {
+		return a.StartsAt.Compare(b.StartsAt)
+	})
+	return res, err
+}
+
+func (r *userRepo) DeleteAbsence(ctx context.Context, userID string, absenceID int64) error {
+	return r.s.do(ctx, func(st *state) error {
+		i := slices.IndexFunc(st.absences, func(a domain.Absence) bool {
+			return a.ID == absenceID && a.UserID == userID
+		})
+		if i < 0 {
+			return domain.ErrAbsenceNotFound
+		}
+		st.absences = slices.Delete(st.absences, i, i+1)
+		return nil
+	})
+}
+
+func (r *userRepo) SetExternalLogin(ctx context.Context, l domain.ExternalLogin) error {
+	return r.s.do(ctx, func(st *state) error {
+		if _, ok := st.users[l.UserID]; !ok {
+			return fmt.Errorf("user %q does not exist", l.UserID)
+		}
+		st.logins[loginKey{l.Provider, l.Login}] = l.UserID
+		return nil
+	})
+}
+
+func (r *userRepo) ResolveLogin(ctx context.Context, provider domain.Provider, login string) (string, error) {
+	var userID string
+	err := r.s.do(ctx, func(st *state) error {
+		id, ok := st.logins[loginKey{provider, login}]
+		if !ok {
+			return domain.ErrUserNotFound
+		}
+		userID = id
+		return nil
+	})
+	return userID, err
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"pr-reviewer-service/internal/domain"
+	"strconv"
+	"time"
+)
+
+type OutboxRepository interface {
+	// Add пишет уведомление в outbox; вызывается внутри транзакции
+	// операции, которая его порождает.
+	Add(ctx context.Context, n domain.Notification) error
+	// LeasePending — до limit записей, готовых к отправке на момент now,
+	// по порядку добавления. Записи сдаются в аренду до until: следующая
+	// попытка назначается на until, так что другой relay их не возьмёт, а
+	// если доставивший упадёт, записи вернутся в очередь после until.
+	// Доставка идёт вне транзакции, итог пишут MarkSent и MarkFailed.
+	LeasePending(ctx context.Context, now, until time.Time, limit int) ([]domain.OutboxEvent, error)
+	MarkSent(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, reason string, next time.Time) error
+	// MarkDead снимает запись с доставки насовсем: получатель её отверг
+	// или попытки кончились. LeasePending её больше не выдаёт.
+	MarkDead(ctx context.Context, id string, reason string) error
+}
+
+type outboxRepo struct {
+	db DB
+}
+
+func NewOutboxRepository(db DB) OutboxRepository {
+	return &outboxRepo{db: db}
+}
+
+func (r *outboxRepo) Add(ctx context.Context, n domain.Notification) error {
+	if n.At.IsZero() {
+		n.At = time.Now().UTC()
+	}
+	n.ID = "" // выдаётся базой
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO outbox (kind, payload) VALUES ($1, $2)`,
+		n.Kind, payload,
+	)
+	return err
+}
+
+func (r *outboxRepo) LeasePending(ctx context.Context, now, until time.Time, limit int) ([]domain.OutboxEvent, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		WITH leased AS (
+			UPDATE outbox SET next_attempt_at=$2
+			 WHERE event_id IN (
+				SELECT event_id
+				  FROM outbox
+				 WHERE sent_at IS NULL AND dead_at IS NULL AND next_attempt_at <= $1
+				 ORDER BY event_id
+				 LIMIT $3
+				   FOR UPDATE SKIP LOCKED
+			 )
+			RETURNING event_id, payload, attempts
+		)
+		SELECT event_id, payload, attempts FROM leased ORDER BY event_id
+	`, now, until, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.OutboxEvent
+	for rows.Next() {
+		var (
+			id      int64
+			payload []byte
+			e       domain.OutboxEvent
+		)
+		if err := rows.Scan(&id, &payload, &e.Attempts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(payload, &e.Notification); err != nil {
+			return nil, err
+		}
+		e.ID = strconv.FormatInt(id, 10)
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
+
+func (r *outboxRepo) MarkSent(ctx context.Context, id string) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).Exec(ctx,
+		`UPDATE outbox SET sent_at=NOW(), last_error=NULL WHERE event_id=$1`,
+		eventID,
+	)
+	return err
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, id string, reason string, next time.Time) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).Exec(ctx,
+		`UPDATE outbox
+		    SET attempts=attempts+1, last_error=$2, next_attempt_at=$3
+		  WHERE event_id=$1`,
+		eventID, reason, next,
+	)
+	return err
+}
+
+func (r *outboxRepo) MarkDead(ctx context.Context, id string, reason string) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).Exec(ctx,
+		`UPDATE outbox SET attempts=attempts+1, last_error=$2, dead_at=NOW() WHERE event_id=$1`,
+		eventID, reason,
+	)
+	return err
+}
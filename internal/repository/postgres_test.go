@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/repositorytest"
+	"pr-reviewer-service/internal/storage"
+)
+
+// Набор гоняется на отдельной базе PostgreSQL: TEST_DB_DSN=postgres://...
+// Перед каждым подтестом все таблицы очищаются.
+func TestPostgresRepositories(t *testing.T) {
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+
+	ctx := context.Background()
+	db, err := storage.NewPostgres(ctx, dsn, storage.PoolConfig{MaxConns: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+	if err := storage.ApplyMigrations(ctx, db.Pool); err != nil {
+		t.Fatal(err)
+	}
+
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		_, err := db.Pool.Exec(ctx, `
+			TRUNCATE teams, users, team_settings, team_codeowners, external_logins, user_absences,
+			         pull_requests, pull_request_reviewers, pull_request_files,
+			         review_escalations, outbox, assignment_events, round_robin_cursors
+			RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return repositorytest.Repositories{
+			Teams:       repository.NewTeamRepository(db.Pool),
+			Users:       repository.NewUserRepository(db.Pool),
+			PRs:         repository.NewPRRepository(db.Pool),
+			Escalations: repository.NewEscalationRepository(db.Pool),
+			Outbox:      repository.NewOutboxRepository(db.Pool),
+			Events:      repository.NewAssignmentEventRepository(db.Pool),
+			Tx:          repository.NewTransactor(db.Pool),
+		}
+	})
+}
@@ -4,20 +4,32 @@ import (
 	"context"
 	"errors"
 	"pr-reviewer-service/internal/domain"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 )
 
 type PRRepository interface {
 	Create(ctx context.Context, pr domain.PullRequest) error
-	AddReviewer(ctx context.Context, prID, reviewerID string) error
+	AddReviewer(ctx context.Context, prID string, reviewer domain.Review) error
 	Get(ctx context.Context, prID string) (domain.PullRequest, error)
 	Merge(ctx context.Context, prID string) error
-	ReplaceReviewer(ctx context.Context, prID, oldUser, newUser string) error
+	SetStatus(ctx context.Context, prID string, status domain.PRStatus) error
+	ReplaceReviewer(ctx context.Context, prID, oldUser string, newReviewer domain.Review) error
 	GetForReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error)
-	Stats(ctx context.Context) (map[string]int, map[string]int, error)
-	ReassignForDeactivated(ctx context.Context, inactive []string) error
+	Stats(ctx context.Context, filter domain.StatsFilter) (domain.Stats, error)
+	Turnaround(ctx context.Context, filter domain.StatsFilter) (domain.TurnaroundSamples, error)
+	AssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.AssignmentCount, error)
+	OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+	GetOpenByReviewers(ctx context.Context, userIDs []string) ([]string, error)
+	RemoveReviewer(ctx context.Context, prID, userID string) error
+	RemoveReviewers(ctx context.Context, prID string) error
+	SetDecision(ctx context.Context, prID, userID string, decision domain.ReviewDecision) error
 }
+
+// ErrReviewerNotFound — ReplaceReviewer не нашёл заменяемого ревьювера на PR.
+var ErrReviewerNotFound = errors.New("reviewer not found")
+
 type prRepo struct {
 	db DB
 }
@@ -27,46 +39,53 @@ func NewPRRepository(db DB) PRRepository {
 }
 
 func (r *prRepo) Create(ctx context.Context, pr domain.PullRequest) error {
-	var exists bool
-
-	err := r.db.QueryRow(ctx,
-		`SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id=$1)`,
-		pr.ID,
-	).Scan(&exists)
-
+	// ON CONFLICT вместо EXISTS+INSERT: при гонке двух запросов
+	// дубликат отсекает первичный ключ
+	tag, err := conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO pull_requests 
+         (pull_request_id, pull_request_name, author_id, status, created_at)
+         VALUES ($1, $2, $3, $4, $5)
+         ON CONFLICT (pull_request_id) DO NOTHING`,
+		pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt,
+	)
 	if err != nil {
 		return err
 	}
-	if exists {
+	if tag.RowsAffected() == 0 {
 		return domain.ErrPRExists
 	}
 
-	_, err = r.db.Exec(ctx,
-		`INSERT INTO pull_requests 
-         (pull_request_id, pull_request_name, author_id, status, created_at)
-         VALUES ($1, $2, $3, $4, $5)`,
-		pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt,
-	)
-	return err
+	for _, path := range pr.Files {
+		_, err := conn(ctx, r.db).Exec(ctx,
+			`INSERT INTO pull_request_files (pull_request_id, path)
+             VALUES ($1, $2)
+             ON CONFLICT DO NOTHING`,
+			pr.ID, path,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
-func (r *prRepo) AddReviewer(ctx context.Context, prID, userID string) error {
-	_, err := r.db.Exec(ctx,
-		`INSERT INTO pull_request_reviewers (pull_request_id, user_id)
-         VALUES ($1, $2)
+func (r *prRepo) AddReviewer(ctx context.Context, prID string, reviewer domain.Review) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO pull_request_reviewers (pull_request_id, user_id, fallback_team)
+         VALUES ($1, $2, NULLIF($3, ''))
          ON CONFLICT DO NOTHING`,
-		prID, userID,
+		prID, reviewer.ReviewerID, reviewer.FallbackTeam,
 	)
 	return err
 }
 func (r *prRepo) Get(ctx context.Context, prID string) (domain.PullRequest, error) {
 	var pr domain.PullRequest
 
-	err := r.db.QueryRow(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, closed_at
            FROM pull_requests
           WHERE pull_request_id=$1`,
 		prID,
-	).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+	).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.ClosedAt)
 
 	if errors.Is(err, pgx.ErrNoRows) {
 		return domain.PullRequest{}, domain.ErrPRNotFound
@@ -75,9 +94,12 @@ func (r *prRepo) Get(ctx context.Context, prID string) (domain.PullRequest, erro
 		return domain.PullRequest{}, err
 	}
 
-	// reviewers
-	rows, err := r.db.Query(ctx,
-		`SELECT user_id FROM pull_request_reviewers WHERE pull_request_id=$1`,
+	// reviewers — в порядке назначения, замена сохраняет место
+	rows, err := conn(ctx, r.db).Query(ctx,
+		`SELECT user_id, decision, decided_at, fallback_team
+		   FROM pull_request_reviewers
+		  WHERE pull_request_id=$1
+		  ORDER BY assignment_seq`,
 		prID,
 	)
 	if err != nil {
@@ -86,16 +108,47 @@ func (r *prRepo) Get(ctx context.Context, prID string) (domain.PullRequest, erro
 	defer rows.Close()
 
 	for rows.Next() {
-		var uid string
-		rows.Scan(&uid)
-		pr.Reviewers = append(pr.Reviewers, uid)
+		var rv domain.Review
+		var decision, fallbackTeam *string
+		if err := rows.Scan(&rv.ReviewerID, &decision, &rv.DecidedAt, &fallbackTeam); err != nil {
+			return domain.PullRequest{}, err
+		}
+		if decision != nil {
+			rv.Decision = domain.ReviewDecision(*decision)
+		}
+		if fallbackTeam != nil {
+			rv.FallbackTeam = *fallbackTeam
+		}
+		pr.Reviewers = append(pr.Reviewers, rv.ReviewerID)
+		pr.Reviews = append(pr.Reviews, rv)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	// files
+	fileRows, err := conn(ctx, r.db).Query(ctx,
+		`SELECT path FROM pull_request_files WHERE pull_request_id=$1 ORDER BY path`,
+		prID,
+	)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	defer fileRows.Close()
+
+	for fileRows.Next() {
+		var path string
+		if err := fileRows.Scan(&path); err != nil {
+			return domain.PullRequest{}, err
+		}
+		pr.Files = append(pr.Files, path)
 	}
 
-	return pr, nil
+	return pr, fileRows.Err()
 }
 
 func (r *prRepo) Merge(ctx context.Context, prID string) error {
-	tag, err := r.db.Exec(ctx,
+	tag, err := conn(ctx, r.db).Exec(ctx,
 		`UPDATE pull_requests
             SET status='MERGED', merged_at=NOW()
           WHERE pull_request_id=$1`,
@@ -109,25 +162,45 @@ func (r *prRepo) Merge(ctx context.Context, prID string) error {
 	}
 	return nil
 }
-func (r *prRepo) ReplaceReviewer(ctx context.Context, prID, oldUser, newUser string) error {
-	tag, err := r.db.Exec(ctx,
+
+// SetStatus переводит PR в DRAFT/OPEN/CLOSED; для MERGED есть Merge.
+func (r *prRepo) SetStatus(ctx context.Context, prID string, status domain.PRStatus) error {
+	tag, err := conn(ctx, r.db).Exec(ctx,
+		`UPDATE pull_requests
+            SET status=$2,
+                closed_at=CASE WHEN $2='CLOSED' THEN NOW() END
+          WHERE pull_request_id=$1`,
+		prID, status,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPRNotFound
+	}
+	return nil
+}
+
+func (r *prRepo) ReplaceReviewer(ctx context.Context, prID, oldUser string, newReviewer domain.Review) error {
+	tag, err := conn(ctx, r.db).Exec(ctx,
 		`UPDATE pull_request_reviewers
-            SET user_id=$3
+            SET user_id=$3, fallback_team=NULLIF($4, ''), decision=NULL, decided_at=NULL,
+                assigned_at=NOW(), first_decided_at=NULL
           WHERE pull_request_id=$1 AND user_id=$2`,
-		prID, oldUser, newUser,
+		prID, oldUser, newReviewer.ReviewerID, newReviewer.FallbackTeam,
 	)
 
 	if err != nil {
 		return err
 	}
 	if tag.RowsAffected() == 0 {
-		return errors.New("reviewer not found")
+		return ErrReviewerNotFound
 	}
 	return nil
 }
 
 func (r *prRepo) GetForReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error) {
-	rows, err := r.db.Query(ctx,
+	rows, err := conn(ctx, r.db).Query(ctx,
 		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
            FROM pull_requests pr
            JOIN pull_request_reviewers prr
@@ -151,90 +224,265 @@ func (r *prRepo) GetForReviewer(ctx context.Context, reviewerID string) ([]domai
 	return result, nil
 }
 
-func (r *prRepo) Stats(ctx context.Context) (map[string]int, map[string]int, error) {
-	rows, err := r.db.Query(ctx, `
-		SELECT user_id, COUNT(*) 
-		FROM pull_request_reviewers 
-		GROUP BY user_id
-	`)
+// Stats считает назначения и авторство по пользователям и PR по статусам.
+// Назначения относятся к команде ревьювера, авторство и статусы — к команде
+// автора; период фильтрует PR по created_at.
+func (r *prRepo) Stats(ctx context.Context, filter domain.StatsFilter) (domain.Stats, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		SELECT u.user_id, u.team_name,
+		       COUNT(*) FILTER (WHERE s.kind = 'review'),
+		       COUNT(*) FILTER (WHERE s.kind = 'review' AND s.status = 'OPEN'),
+		       COUNT(*) FILTER (WHERE s.kind = 'review' AND s.status = 'MERGED'),
+		       COUNT(*) FILTER (WHERE s.kind = 'author')
+		FROM (
+			SELECT prr.user_id, 'review' AS kind, pr.status
+			FROM pull_request_reviewers prr
+			JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			WHERE ($2::timestamptz IS NULL OR pr.created_at >= $2)
+			  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+			UNION ALL
+			SELECT pr.author_id, 'author', pr.status
+			FROM pull_requests pr
+			WHERE ($2::timestamptz IS NULL OR pr.created_at >= $2)
+			  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+		) s
+		JOIN users u ON u.user_id = s.user_id
+		WHERE $1 = '' OR u.team_name = $1
+		GROUP BY u.user_id, u.team_name
+		ORDER BY u.team_name, u.user_id
+	`, filter.Team, filter.From, filter.To)
 	if err != nil {
-		return nil, nil, err
+		return domain.Stats{}, err
 	}
 	defer rows.Close()
 
-	reviewerCount := map[string]int{}
+	var stats domain.Stats
 	for rows.Next() {
-		var id string
-		var cnt int
-		if err := rows.Scan(&id, &cnt); err != nil {
-			return nil, nil, err
+		var us domain.UserStats
+		if err := rows.Scan(&us.UserID, &us.TeamName,
+			&us.Assignments, &us.OpenAssignments, &us.MergedAssignments, &us.AuthoredPRs); err != nil {
+			return domain.Stats{}, err
 		}
-		reviewerCount[id] = cnt
+		stats.Users = append(stats.Users, us)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.Stats{}, err
 	}
 
-	rows2, err := r.db.Query(ctx, `
-		SELECT status, COUNT(*)
-		FROM pull_requests
-		GROUP BY status
-	`)
+	rows2, err := conn(ctx, r.db).Query(ctx, `
+		SELECT pr.status::text, COUNT(*)
+		FROM pull_requests pr
+		JOIN users u ON u.user_id = pr.author_id
+		WHERE ($1 = '' OR u.team_name = $1)
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+		GROUP BY pr.status
+	`, filter.Team, filter.From, filter.To)
 	if err != nil {
-		return nil, nil, err
+		return domain.Stats{}, err
 	}
 	defer rows2.Close()
 
-	statusCount := map[string]int{}
+	stats.PRStatus = map[domain.PRStatus]int{}
 	for rows2.Next() {
 		var status string
 		var cnt int
 		if err := rows2.Scan(&status, &cnt); err != nil {
-			return nil, nil, err
+			return domain.Stats{}, err
 		}
-		statusCount[status] = cnt
+		stats.PRStatus[domain.PRStatus(status)] = cnt
 	}
 
-	return reviewerCount, statusCount, nil
+	return stats, rows2.Err()
 }
-func (r *prRepo) ReassignForDeactivated(ctx context.Context, inactive []string) error {
-	rows, err := r.db.Query(ctx, `
-		SELECT prr.pull_request_id
+
+// Turnaround выбирает измерения для метрик скорости ревью. Период
+// фильтрует PR по created_at; команда — автора для Merges
+// и ревьювера для ReviewerMerges и Reviews.
+func (r *prRepo) Turnaround(ctx context.Context, filter domain.StatsFilter) (domain.TurnaroundSamples, error) {
+	var res domain.TurnaroundSamples
+	var err error
+
+	res.Merges, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::float8
+		FROM pull_requests pr
+		JOIN users u ON u.user_id = pr.author_id
+		WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+		  AND ($1 = '' OR u.team_name = $1)
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+	`, filter)
+	if err != nil {
+		return res, err
+	}
+
+	res.ReviewerMerges, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::float8
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = prr.user_id
+		WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+		  AND ($1 = '' OR u.team_name = $1)
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+	`, filter)
+	if err != nil {
+		return res, err
+	}
+
+	res.Reviews, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, EXTRACT(EPOCH FROM prr.first_decided_at - prr.assigned_at)::float8
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = prr.user_id
+		WHERE prr.first_decided_at IS NOT NULL
+		  AND ($1 = '' OR u.team_name = $1)
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+	`, filter)
+	return res, err
+}
+
+// durations выполняет запрос вида (user_id, team_name, секунды)
+// с параметрами фильтра $1..$3.
+func (r *prRepo) durations(ctx context.Context, query string, filter domain.StatsFilter) ([]domain.DurationSample, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, filter.Team, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.DurationSample
+	for rows.Next() {
+		var x domain.DurationSample
+		var seconds float64
+		if err := rows.Scan(&x.UserID, &x.TeamName, &seconds); err != nil {
+			return nil, err
+		}
+		x.Duration = time.Duration(seconds * float64(time.Second))
+		res = append(res, x)
+	}
+	return res, rows.Err()
+}
+
+// AssignmentCounts — назначения по пользователям и неделям (по assigned_at)
+// в полуинтервале [From, To). Активные участники без назначений попадают
+// в ответ одной строкой с нулевой неделей.
+func (r *prRepo) AssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.AssignmentCount, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		SELECT u.user_id, u.team_name,
+		       date_trunc('week', prr.assigned_at AT TIME ZONE 'UTC'),
+		       COUNT(prr.user_id)
+		FROM users u
+		LEFT JOIN pull_request_reviewers prr
+		       ON prr.user_id = u.user_id
+		      AND ($2::timestamptz IS NULL OR prr.assigned_at >= $2)
+		      AND ($3::timestamptz IS NULL OR prr.assigned_at < $3)
+		WHERE ($1 = '' OR u.team_name = $1)
+		  AND (u.is_active OR prr.user_id IS NOT NULL)
+		GROUP BY 1, 2, 3
+		ORDER BY u.team_name, u.user_id, 3
+	`, filter.Team, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.AssignmentCount
+	for rows.Next() {
+		var c domain.AssignmentCount
+		var week *time.Time
+		if err := rows.Scan(&c.UserID, &c.TeamName, &week, &c.Count); err != nil {
+			return nil, err
+		}
+		if week != nil {
+			c.Week = time.Date(week.Year(), week.Month(), week.Day(), 0, 0, 0, 0, time.UTC)
+		}
+		res = append(res, c)
+	}
+	return res, rows.Err()
+}
+
+// OpenReviewCounts — число назначений на OPEN PR для каждого из userIDs
+// (пользователи без назначений в ответ не попадают).
+func (r *prRepo) OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		SELECT prr.user_id, COUNT(*)
 		FROM pull_request_reviewers prr
 		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
 		WHERE pr.status = 'OPEN'
 		  AND prr.user_id = ANY($1)
-	`, inactive)
+		GROUP BY prr.user_id
+	`, userIDs)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var id string
+		var cnt int
+		if err := rows.Scan(&id, &cnt); err != nil {
+			return nil, err
+		}
+		counts[id] = cnt
+	}
+	return counts, rows.Err()
+}
+
+func (r *prRepo) GetOpenByReviewers(ctx context.Context, userIDs []string) ([]string, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, `
+		SELECT DISTINCT prr.pull_request_id
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.status = 'OPEN'
+		  AND prr.user_id = ANY($1)
+	`, userIDs)
+	if err != nil {
+		return nil, err
 	}
 	defer rows.Close()
 
 	var prIDs []string
 	for rows.Next() {
 		var id string
-		rows.Scan(&id)
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
 		prIDs = append(prIDs, id)
 	}
+	return prIDs, rows.Err()
+}
 
-	if len(prIDs) == 0 {
-		return nil
-	}
+func (r *prRepo) RemoveReviewer(ctx context.Context, prID, userID string) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`DELETE FROM pull_request_reviewers WHERE pull_request_id=$1 AND user_id=$2`,
+		prID, userID,
+	)
+	return err
+}
 
-	_, err = r.db.Exec(ctx, `
-		DELETE FROM pull_request_reviewers
-		WHERE user_id = ANY($1)
-	`, inactive)
+func (r *prRepo) SetDecision(ctx context.Context, prID, userID string, decision domain.ReviewDecision) error {
+	tag, err := conn(ctx, r.db).Exec(ctx,
+		`UPDATE pull_request_reviewers
+            SET decision=$3, decided_at=NOW(), first_decided_at=COALESCE(first_decided_at, NOW())
+          WHERE pull_request_id=$1 AND user_id=$2`,
+		prID, userID, decision,
+	)
 	if err != nil {
 		return err
 	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotAssigned
+	}
+	return nil
+}
 
-	_, err = r.db.Exec(ctx, `
-		INSERT INTO pull_request_reviewers (pull_request_id, user_id)
-		SELECT pr.pull_request_id, u.user_id
-		FROM pull_requests pr
-		JOIN users u ON u.team_name = (SELECT team_name FROM users WHERE user_id = pr.author_id)
-		WHERE pr.pull_request_id = ANY($1)
-		  AND u.is_active = TRUE
-		  AND u.user_id != pr.author_id
-	`, prIDs)
-
+func (r *prRepo) RemoveReviewers(ctx context.Context, prID string) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`DELETE FROM pull_request_reviewers WHERE pull_request_id=$1`,
+		prID,
+	)
 	return err
 }
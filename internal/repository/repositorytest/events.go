@@ -0,0 +1,217 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+func testEscalations(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2")
+	seedTeam(t, r, "nosla", "other", "o1")
+	check(t, r.Teams.SaveSettings(ctx, domain.TeamSettings{
+		TeamName: "backend", ReviewerStrategy: domain.StrategyRandom, ReviewerCount: 2,
+		ReviewSLAHours: 1, EscalationHours: 3,
+	}))
+	seedPR(t, r, "pr-1", "author", "u1", "u2")
+	seedPR(t, r, "merged", "author", "u1")
+	check(t, r.PRs.Merge(ctx, "merged"))
+	// у команды автора SLA выключен
+	seedPR(t, r, "pr-2", "other", "o1")
+
+	overdue, err := r.Escalations.Overdue(ctx, time.Now())
+	check(t, err)
+	if len(overdue) != 0 {
+		t.Errorf("overdue right after assignment = %+v", overdue)
+	}
+
+	// решение снимает назначение с контроля SLA
+	check(t, r.PRs.SetDecision(ctx, "pr-1", "u2", domain.DecisionCommented))
+
+	later := time.Now().Add(2 * time.Hour)
+	overdue, err = r.Escalations.Overdue(ctx, later)
+	check(t, err)
+	if len(overdue) != 1 {
+		t.Fatalf("overdue = %+v, want u1 on pr-1", overdue)
+	}
+	o := overdue[0]
+	if o.PRID != "pr-1" || o.ReviewerID != "u1" || o.TeamName != "backend" ||
+		o.SLA != time.Hour || o.EscalateAfter != 3*time.Hour || o.Reminded || o.AssignedAt.IsZero() {
+		t.Errorf("overdue review = %+v", o)
+	}
+
+	reminder, err := r.Escalations.Record(ctx, domain.Escalation{
+		PRID: "pr-1", ReviewerID: "u1", Kind: domain.EscalationReminder, AssignedAt: o.AssignedAt,
+	})
+	check(t, err)
+	if reminder.ID == 0 || reminder.CreatedAt.IsZero() {
+		t.Errorf("recorded escalation = %+v", reminder)
+	}
+
+	overdue, err = r.Escalations.Overdue(ctx, later)
+	check(t, err)
+	if len(overdue) != 1 || !overdue[0].Reminded {
+		t.Errorf("overdue after reminder = %+v", overdue)
+	}
+
+	_, err = r.Escalations.Record(ctx, domain.Escalation{
+		PRID: "pr-1", ReviewerID: "u1", Kind: domain.EscalationReassign, AssignedAt: o.AssignedAt,
+		ReplacedBy: "u2", Reason: "no decision in 3h",
+	})
+	check(t, err)
+
+	list, err := r.Escalations.ListByPR(ctx, "pr-1")
+	check(t, err)
+	if len(list) != 2 || list[0].Kind != domain.EscalationReminder || list[1].Kind != domain.EscalationReassign {
+		t.Fatalf("escalations = %+v", list)
+	}
+	if e := list[1]; e.ReplacedBy != "u2" || e.Reason != "no decision in 3h" || !e.AssignedAt.Equal(o.AssignedAt) {
+		t.Errorf("reassign escalation = %+v", e)
+	}
+	if list[0].ReplacedBy != "" {
+		t.Errorf("reminder replaced_by = %q", list[0].ReplacedBy)
+	}
+
+	// новое назначение отсчитывает SLA заново, старое напоминание не в счёт
+	check(t, r.PRs.ReplaceReviewer(ctx, "pr-1", "u1", domain.Review{ReviewerID: "author"}))
+	overdue, err = r.Escalations.Overdue(ctx, later)
+	check(t, err)
+	if len(overdue) != 1 || overdue[0].ReviewerID != "author" || overdue[0].Reminded {
+		t.Errorf("overdue after reassignment = %+v", overdue)
+	}
+}
+
+func testAssignmentEvents(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2")
+	seedPR(t, r, "pr-1", "author")
+	seedPR(t, r, "pr-2", "author")
+
+	events := []domain.AssignmentEvent{
+		{PRID: "pr-1", UserID: "u1", Action: domain.ActionAssigned, Reason: domain.ReasonAuto, Actor: "system"},
+		{PRID: "pr-2", UserID: "u1", Action: domain.ActionAssigned, Reason: domain.ReasonAuto, Actor: "system"},
+		{PRID: "pr-1", UserID: "u1", Action: domain.ActionUnassigned, Reason: domain.ReasonReassign, Detail: "replaced by u2", Actor: "u1"},
+		{PRID: "pr-1", UserID: "u2", Action: domain.ActionAssigned, Reason: domain.ReasonReassign, Detail: "replaces u1", Actor: "u1"},
+	}
+	for _, e := range events {
+		check(t, r.Events.Add(ctx, e))
+	}
+
+	list, err := r.Events.ListByPR(ctx, "pr-1")
+	check(t, err)
+	if len(list) != 3 {
+		t.Fatalf("events = %+v", list)
+	}
+	// журнал — в порядке записи
+	for i, want := range []domain.AssignmentEvent{events[0], events[2], events[3]} {
+		got := list[i]
+		if got.ID == 0 || got.CreatedAt.IsZero() {
+			t.Errorf("event %d has no id or time: %+v", i, got)
+		}
+		if i > 0 && got.ID <= list[i-1].ID {
+			t.Errorf("event ids are not increasing: %+v", list)
+		}
+		got.ID, got.CreatedAt = 0, time.Time{}
+		if got != want {
+			t.Errorf("event %d = %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func testOutbox(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	at := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	for _, pr := range []string{"pr-1", "pr-2", "pr-3"} {
+		check(t, r.Outbox.Add(ctx, domain.Notification{
+			Kind: domain.NotifyReviewerAssigned, PRID: pr, UserID: "u1", At: at,
+		}))
+	}
+
+	// с запасом на расхождение часов с сервером БД
+	now := time.Now().Add(time.Minute)
+	lease := now.Add(10 * time.Minute)
+
+	batch, err := r.Outbox.LeasePending(ctx, now, lease, 2)
+	check(t, err)
+	if len(batch) != 2 || batch[0].PRID != "pr-1" || batch[1].PRID != "pr-2" {
+		t.Fatalf("first batch = %+v", batch)
+	}
+	first := batch[0]
+	if first.ID == "" || first.Attempts != 0 || first.Kind != domain.NotifyReviewerAssigned ||
+		first.UserID != "u1" || !first.At.Equal(at) {
+		t.Errorf("leased event = %+v", first)
+	}
+
+	// арендованные записи не выдаются повторно до конца аренды
+	batch, err = r.Outbox.LeasePending(ctx, now, lease, 10)
+	check(t, err)
+	if len(batch) != 1 || batch[0].PRID != "pr-3" {
+		t.Fatalf("second batch = %+v", batch)
+	}
+
+	check(t, r.Outbox.MarkSent(ctx, first.ID))
+	check(t, r.Outbox.MarkFailed(ctx, batch[0].ID, "receiver is down", lease.Add(time.Hour)))
+
+	// после конца аренды возвращается только недоставленная pr-2;
+	// pr-3 ждёт своей попытки
+	batch, err = r.Outbox.LeasePending(ctx, lease, lease.Add(10*time.Minute), 10)
+	check(t, err)
+	if len(batch) != 1 || batch[0].PRID != "pr-2" || batch[0].Attempts != 0 {
+		t.Fatalf("batch after lease expiry = %+v", batch)
+	}
+	check(t, r.Outbox.MarkSent(ctx, batch[0].ID))
+
+	batch, err = r.Outbox.LeasePending(ctx, lease.Add(time.Hour), lease.Add(2*time.Hour), 10)
+	check(t, err)
+	if len(batch) != 1 || batch[0].PRID != "pr-3" || batch[0].Attempts != 1 {
+		t.Fatalf("retried batch = %+v", batch)
+	}
+
+	// снятая с доставки запись не возвращается и после конца аренды
+	check(t, r.Outbox.MarkDead(ctx, batch[0].ID, "receiver rejected the event"))
+	batch, err = r.Outbox.LeasePending(ctx, lease.Add(24*time.Hour), lease.Add(25*time.Hour), 10)
+	check(t, err)
+	if len(batch) != 0 {
+		t.Errorf("dead event leased again: %+v", batch)
+	}
+}
+
+func testTransactions(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	errAbort := errors.New("abort")
+
+	err := r.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := r.Teams.Create(ctx, "rolled-back"); err != nil {
+			return err
+		}
+		// вложенная транзакция присоединяется к внешней и откатывается с ней
+		if err := r.Tx.WithinTx(ctx, func(ctx context.Context) error {
+			return r.Teams.Create(ctx, "nested")
+		}); err != nil {
+			return err
+		}
+		// внутри транзакции видны её собственные изменения
+		if _, err := r.Teams.Get(ctx, "nested"); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	wantErr(t, err, errAbort)
+
+	for _, name := range []string{"rolled-back", "nested"} {
+		if _, err := r.Teams.Get(ctx, name); err == nil {
+			t.Errorf("team %q survived the rollback", name)
+		}
+	}
+
+	check(t, r.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		return r.Teams.Create(ctx, "committed")
+	}))
+	if _, err := r.Teams.Get(ctx, "committed"); err != nil {
+		t.Errorf("committed team: %v", err)
+	}
+}
@@ -0,0 +1,311 @@
+package repositorytest
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+func testPullRequests(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author")
+
+	_, err := r.PRs.Get(ctx, "pr-1")
+	wantErr(t, err, domain.ErrPRNotFound)
+
+	created := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	pr := domain.PullRequest{
+		ID:        "pr-1",
+		Name:      "Add search",
+		AuthorID:  "author",
+		Status:    domain.PRStatusDraft,
+		Files:     []string{"b/main.go", "a/api.go", "b/main.go"},
+		CreatedAt: created,
+	}
+	check(t, r.PRs.Create(ctx, pr))
+	wantErr(t, r.PRs.Create(ctx, pr), domain.ErrPRExists)
+
+	got := getPR(t, r, "pr-1")
+	if got.ID != "pr-1" || got.Name != "Add search" || got.AuthorID != "author" ||
+		got.Status != domain.PRStatusDraft || !got.CreatedAt.Equal(created) ||
+		got.MergedAt != nil || got.ClosedAt != nil || len(got.Reviewers) != 0 {
+		t.Errorf("PR = %+v", got)
+	}
+	// файлы — без повторов, по алфавиту
+	if len(got.Files) != 2 || got.Files[0] != "a/api.go" || got.Files[1] != "b/main.go" {
+		t.Errorf("files = %v", got.Files)
+	}
+}
+
+func testReviewers(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2")
+	seedTeam(t, r, "platform", "p1")
+	seedPR(t, r, "pr-1", "author", "u1")
+
+	check(t, r.PRs.AddReviewer(ctx, "pr-1", domain.Review{ReviewerID: "p1", FallbackTeam: "platform"}))
+	// повторное назначение ничего не меняет
+	check(t, r.PRs.AddReviewer(ctx, "pr-1", domain.Review{ReviewerID: "u1"}))
+
+	pr := getPR(t, r, "pr-1")
+	equalIDs(t, "reviewers", pr.Reviewers, "u1", "p1")
+	reviews := map[string]domain.Review{}
+	for _, rv := range pr.Reviews {
+		reviews[rv.ReviewerID] = rv
+	}
+	if reviews["p1"].FallbackTeam != "platform" || reviews["u1"].FallbackTeam != "" {
+		t.Errorf("fallback teams = %+v", pr.Reviews)
+	}
+	if reviews["u1"].Decision != "" || reviews["u1"].DecidedAt != nil {
+		t.Errorf("undecided review = %+v", reviews["u1"])
+	}
+
+	wantErr(t, r.PRs.SetDecision(ctx, "pr-1", "u2", domain.DecisionApproved), domain.ErrNotAssigned)
+	check(t, r.PRs.SetDecision(ctx, "pr-1", "u1", domain.DecisionChangesRequested))
+	check(t, r.PRs.SetDecision(ctx, "pr-1", "u1", domain.DecisionApproved))
+
+	pr = getPR(t, r, "pr-1")
+	if pr.Approvals() != 1 {
+		t.Errorf("approvals = %d, want 1", pr.Approvals())
+	}
+	for _, rv := range pr.Reviews {
+		if rv.ReviewerID == "u1" && (rv.Decision != domain.DecisionApproved || rv.DecidedAt == nil) {
+			t.Errorf("u1 review = %+v", rv)
+		}
+	}
+
+	// замена сбрасывает решение и переносит отметку fallback
+	check(t, r.PRs.ReplaceReviewer(ctx, "pr-1", "u1", domain.Review{ReviewerID: "u2", FallbackTeam: "backend"}))
+	wantErr(t, r.PRs.ReplaceReviewer(ctx, "pr-1", "u1", domain.Review{ReviewerID: "u2"}), repository.ErrReviewerNotFound)
+	pr = getPR(t, r, "pr-1")
+	equalIDs(t, "reviewers after replace", pr.Reviewers, "u2", "p1")
+	for _, rv := range pr.Reviews {
+		if rv.ReviewerID == "u2" && (rv.Decision != "" || rv.DecidedAt != nil || rv.FallbackTeam != "backend") {
+			t.Errorf("replacement review = %+v", rv)
+		}
+	}
+
+	check(t, r.PRs.RemoveReviewer(ctx, "pr-1", "p1"))
+	check(t, r.PRs.RemoveReviewer(ctx, "pr-1", "p1"))
+	equalIDs(t, "reviewers after remove", getPR(t, r, "pr-1").Reviewers, "u2")
+
+	check(t, r.PRs.RemoveReviewers(ctx, "pr-1"))
+	equalIDs(t, "reviewers after remove all", getPR(t, r, "pr-1").Reviewers)
+}
+
+// Ревьюверы возвращаются в порядке назначения, даже если назначены в одной
+// транзакции, а замена занимает место заменённого.
+func testReviewerOrder(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2", "u3", "u4")
+	seedPR(t, r, "pr-1", "author")
+
+	check(t, r.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		for _, id := range []string{"u3", "u1", "u2"} {
+			if err := r.PRs.AddReviewer(ctx, "pr-1", domain.Review{ReviewerID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	inOrder(t, getPR(t, r, "pr-1"), "u3", "u1", "u2")
+
+	check(t, r.PRs.ReplaceReviewer(ctx, "pr-1", "u1", domain.Review{ReviewerID: "u4"}))
+	inOrder(t, getPR(t, r, "pr-1"), "u3", "u4", "u2")
+
+	check(t, r.PRs.RemoveReviewer(ctx, "pr-1", "u3"))
+	check(t, r.PRs.AddReviewer(ctx, "pr-1", domain.Review{ReviewerID: "u1"}))
+	inOrder(t, getPR(t, r, "pr-1"), "u4", "u2", "u1")
+}
+
+func inOrder(t *testing.T, pr domain.PullRequest, want ...string) {
+	t.Helper()
+	if !slices.Equal(pr.Reviewers, want) {
+		t.Errorf("reviewers = %v, want %v", pr.Reviewers, want)
+	}
+	for i, rv := range pr.Reviews {
+		if i >= len(want) || rv.ReviewerID != want[i] {
+			t.Errorf("reviews = %+v, want %v", pr.Reviews, want)
+			break
+		}
+	}
+}
+
+func testPRStatus(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author")
+	seedPR(t, r, "pr-1", "author")
+
+	wantErr(t, r.PRs.SetStatus(ctx, "missing", domain.PRStatusClosed), domain.ErrPRNotFound)
+	wantErr(t, r.PRs.Merge(ctx, "missing"), domain.ErrPRNotFound)
+
+	check(t, r.PRs.SetStatus(ctx, "pr-1", domain.PRStatusClosed))
+	pr := getPR(t, r, "pr-1")
+	if pr.Status != domain.PRStatusClosed || pr.ClosedAt == nil {
+		t.Errorf("closed PR = %+v", pr)
+	}
+
+	// повторное открытие снимает closed_at
+	check(t, r.PRs.SetStatus(ctx, "pr-1", domain.PRStatusOpen))
+	pr = getPR(t, r, "pr-1")
+	if pr.Status != domain.PRStatusOpen || pr.ClosedAt != nil {
+		t.Errorf("reopened PR = %+v", pr)
+	}
+
+	check(t, r.PRs.Merge(ctx, "pr-1"))
+	pr = getPR(t, r, "pr-1")
+	if pr.Status != domain.PRStatusMerged || pr.MergedAt == nil {
+		t.Errorf("merged PR = %+v", pr)
+	}
+}
+
+func testReviewerQueries(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2", "u3")
+	seedPR(t, r, "pr-1", "author", "u1", "u2")
+	seedPR(t, r, "pr-2", "author", "u1")
+	seedPR(t, r, "pr-3", "author", "u1", "u2")
+	check(t, r.PRs.Merge(ctx, "pr-3"))
+
+	short, err := r.PRs.GetForReviewer(ctx, "u1")
+	check(t, err)
+	ids := make([]string, 0, len(short))
+	for _, p := range short {
+		ids = append(ids, p.ID)
+		if p.ID == "pr-3" && (p.Status != domain.PRStatusMerged || p.AuthorID != "author" || p.Name != "name pr-3") {
+			t.Errorf("short PR = %+v", p)
+		}
+	}
+	// в список попадают PR в любом статусе
+	equalIDs(t, "PRs of u1", ids, "pr-1", "pr-2", "pr-3")
+
+	short, err = r.PRs.GetForReviewer(ctx, "u3")
+	check(t, err)
+	if len(short) != 0 {
+		t.Errorf("PRs of u3 = %+v", short)
+	}
+
+	counts, err := r.PRs.OpenReviewCounts(ctx, []string{"u1", "u2", "u3"})
+	check(t, err)
+	if counts["u1"] != 2 || counts["u2"] != 1 || counts["u3"] != 0 {
+		t.Errorf("open review counts = %v", counts)
+	}
+
+	open, err := r.PRs.GetOpenByReviewers(ctx, []string{"u2", "u3"})
+	check(t, err)
+	equalIDs(t, "open PRs of u2, u3", open, "pr-1")
+	open, err = r.PRs.GetOpenByReviewers(ctx, []string{"u1", "u2"})
+	check(t, err)
+	equalIDs(t, "open PRs of u1, u2", open, "pr-1", "pr-2")
+}
+
+func testStats(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "b1", "b2")
+	seedTeam(t, r, "platform", "p1")
+	seedPR(t, r, "pr-1", "b1", "b2", "p1")
+	seedPR(t, r, "pr-2", "b1", "b2")
+	seedPR(t, r, "pr-3", "p1", "b1")
+	check(t, r.PRs.Merge(ctx, "pr-2"))
+
+	stats, err := r.PRs.Stats(ctx, domain.StatsFilter{})
+	check(t, err)
+	want := []domain.UserStats{
+		{UserID: "b1", TeamName: "backend", Assignments: 1, OpenAssignments: 1, AuthoredPRs: 2},
+		{UserID: "b2", TeamName: "backend", Assignments: 2, OpenAssignments: 1, MergedAssignments: 1},
+		{UserID: "p1", TeamName: "platform", Assignments: 1, OpenAssignments: 1, AuthoredPRs: 1},
+	}
+	if len(stats.Users) != len(want) {
+		t.Fatalf("user stats = %+v, want %+v", stats.Users, want)
+	}
+	for i := range want {
+		if stats.Users[i] != want[i] {
+			t.Errorf("user stats[%d] = %+v, want %+v", i, stats.Users[i], want[i])
+		}
+	}
+	if stats.PRStatus[domain.PRStatusOpen] != 2 || stats.PRStatus[domain.PRStatusMerged] != 1 {
+		t.Errorf("PR statuses = %v", stats.PRStatus)
+	}
+
+	// фильтр по команде: статусы — по команде автора
+	stats, err = r.PRs.Stats(ctx, domain.StatsFilter{Team: "platform"})
+	check(t, err)
+	if len(stats.Users) != 1 || stats.Users[0] != want[2] {
+		t.Errorf("platform user stats = %+v", stats.Users)
+	}
+	if stats.PRStatus[domain.PRStatusOpen] != 1 || stats.PRStatus[domain.PRStatusMerged] != 0 {
+		t.Errorf("platform PR statuses = %v", stats.PRStatus)
+	}
+
+	// период — по времени создания PR
+	from := time.Now().Add(time.Hour)
+	stats, err = r.PRs.Stats(ctx, domain.StatsFilter{From: &from})
+	check(t, err)
+	if len(stats.Users) != 0 || stats.PRStatus[domain.PRStatusOpen] != 0 {
+		t.Errorf("stats for the future = %+v", stats)
+	}
+}
+
+func testTurnaround(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "u2")
+	seedPR(t, r, "pr-1", "author", "u1", "u2")
+	check(t, r.PRs.SetDecision(ctx, "pr-1", "u1", domain.DecisionApproved))
+	check(t, r.PRs.Merge(ctx, "pr-1"))
+	seedPR(t, r, "pr-2", "author", "u1")
+
+	samples, err := r.PRs.Turnaround(ctx, domain.StatsFilter{})
+	check(t, err)
+
+	if len(samples.Merges) != 1 || samples.Merges[0].UserID != "author" || samples.Merges[0].TeamName != "backend" {
+		t.Errorf("merges = %+v", samples.Merges)
+	}
+	var reviewerMerges []string
+	for _, x := range samples.ReviewerMerges {
+		reviewerMerges = append(reviewerMerges, x.UserID)
+	}
+	equalIDs(t, "reviewer merges", reviewerMerges, "u1", "u2")
+	if len(samples.Reviews) != 1 || samples.Reviews[0].UserID != "u1" || samples.Reviews[0].Duration < 0 {
+		t.Errorf("reviews = %+v", samples.Reviews)
+	}
+
+	samples, err = r.PRs.Turnaround(ctx, domain.StatsFilter{Team: "other"})
+	check(t, err)
+	if len(samples.Merges)+len(samples.ReviewerMerges)+len(samples.Reviews) != 0 {
+		t.Errorf("samples of an unknown team = %+v", samples)
+	}
+}
+
+func testAssignmentCounts(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "author", "u1", "idle", "gone")
+	seedPR(t, r, "pr-1", "author", "u1")
+	seedPR(t, r, "pr-2", "author", "u1")
+	check(t, r.Users.SetActive(ctx, "gone", false))
+
+	counts, err := r.PRs.AssignmentCounts(ctx, domain.StatsFilter{Team: "backend"})
+	check(t, err)
+
+	week := domain.WeekStart(time.Now().UTC())
+	got := map[string]domain.AssignmentCount{}
+	for _, c := range counts {
+		got[c.UserID] = c
+	}
+	// неактивные без назначений не попадают в отчёт, активные — с нулём
+	if len(counts) != 3 {
+		t.Fatalf("counts = %+v", counts)
+	}
+	if c := got["u1"]; c.Count != 2 || !c.Week.Equal(week) {
+		t.Errorf("u1 = %+v, want 2 in week %v", c, week)
+	}
+	if c := got["idle"]; c.Count != 0 || !c.Week.IsZero() {
+		t.Errorf("idle = %+v", c)
+	}
+	if c, ok := got["author"]; !ok || c.Count != 0 {
+		t.Errorf("author = %+v", c)
+	}
+}
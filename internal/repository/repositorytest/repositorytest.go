@@ -0,0 +1,146 @@
+// Package repositorytest — общий набор проверок для реализаций репозиториев.
+// Его прогоняет каждое хранилище (PostgreSQL, SQLite, память), чтобы их
+// поведение не расходилось: одни и те же ошибки, порядок и граничные случаи.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+// Repositories — репозитории одного хранилища.
+type Repositories struct {
+	Teams       repository.TeamRepository
+	Users       repository.UserRepository
+	PRs         repository.PRRepository
+	Escalations repository.EscalationRepository
+	Outbox      repository.OutboxRepository
+	Events      repository.AssignmentEventRepository
+	Tx          repository.Transactor
+}
+
+// Run прогоняет набор. newRepos вызывается в каждом подтесте и должна
+// возвращать пустое хранилище.
+func Run(t *testing.T, newRepos func(t *testing.T) Repositories) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r Repositories)
+	}{
+		{"Teams", testTeams},
+		{"TeamSettings", testTeamSettings},
+		{"Codeowners", testCodeowners},
+		{"RoundRobin", testRoundRobin},
+		{"Users", testUsers},
+		{"Absences", testAbsences},
+		{"AbsenceUpsert", testAbsenceUpsert},
+		{"ExternalLogins", testExternalLogins},
+		{"PullRequests", testPullRequests},
+		{"Reviewers", testReviewers},
+		{"ReviewerOrder", testReviewerOrder},
+		{"PRStatus", testPRStatus},
+		{"ReviewerQueries", testReviewerQueries},
+		{"Stats", testStats},
+		{"Turnaround", testTurnaround},
+		{"AssignmentCounts", testAssignmentCounts},
+		{"Escalations", testEscalations},
+		{"AssignmentEvents", testAssignmentEvents},
+		{"Outbox", testOutbox},
+		{"Transactions", testTransactions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepos(t))
+		})
+	}
+}
+
+// ----------------- HELPERS -----------------
+
+// seedTeam создаёт команду с активными участниками.
+func seedTeam(t *testing.T, r Repositories, team string, userIDs ...string) {
+	t.Helper()
+	ctx := context.Background()
+	if err := r.Teams.Create(ctx, team); err != nil {
+		t.Fatalf("create team %s: %v", team, err)
+	}
+	members := make([]domain.TeamMember, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, domain.TeamMember{ID: id, Username: "name-" + id, IsActive: true})
+	}
+	if err := r.Teams.AddMembers(ctx, team, members); err != nil {
+		t.Fatalf("add members to %s: %v", team, err)
+	}
+}
+
+// seedPR создаёт OPEN PR с ревьюверами.
+func seedPR(t *testing.T, r Repositories, id, author string, reviewers ...string) {
+	t.Helper()
+	ctx := context.Background()
+	err := r.PRs.Create(ctx, domain.PullRequest{
+		ID:        id,
+		Name:      "name " + id,
+		AuthorID:  author,
+		Status:    domain.PRStatusOpen,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+	if err != nil {
+		t.Fatalf("create PR %s: %v", id, err)
+	}
+	for _, rv := range reviewers {
+		if err := r.PRs.AddReviewer(ctx, id, domain.Review{ReviewerID: rv}); err != nil {
+			t.Fatalf("add reviewer %s to %s: %v", rv, id, err)
+		}
+	}
+}
+
+func getPR(t *testing.T, r Repositories, id string) domain.PullRequest {
+	t.Helper()
+	pr, err := r.PRs.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get PR %s: %v", id, err)
+	}
+	return pr
+}
+
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func wantErr(t *testing.T, err, want error) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Fatalf("err = %v, want %v", err, want)
+	}
+}
+
+// sorted — копия ids по возрастанию: порядок строк без ORDER BY
+// хранилища не обязаны соблюдать.
+func sorted(ids []string) []string {
+	res := slices.Clone(ids)
+	slices.Sort(res)
+	return res
+}
+
+func userIDs(users []domain.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return sorted(ids)
+}
+
+func equalIDs(t *testing.T, what string, got []string, want ...string) {
+	t.Helper()
+	if !slices.Equal(sorted(got), sorted(want)) {
+		t.Errorf("%s = %v, want %v", what, sorted(got), sorted(want))
+	}
+}
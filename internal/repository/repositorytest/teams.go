@@ -0,0 +1,161 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+func testTeams(t *testing.T, r Repositories) {
+	ctx := context.Background()
+
+	_, err := r.Teams.Get(ctx, "backend")
+	wantErr(t, err, repository.ErrTeamNotFound)
+
+	check(t, r.Teams.Create(ctx, "backend"))
+	wantErr(t, r.Teams.Create(ctx, "backend"), repository.ErrTeamExists)
+
+	team, err := r.Teams.Get(ctx, "backend")
+	check(t, err)
+	if team.Name != "backend" || len(team.Members) != 0 {
+		t.Errorf("empty team = %+v", team)
+	}
+
+	check(t, r.Teams.AddMembers(ctx, "backend", []domain.TeamMember{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: false},
+	}))
+	team, err = r.Teams.Get(ctx, "backend")
+	check(t, err)
+	members := map[string]domain.TeamMember{}
+	for _, m := range team.Members {
+		members[m.ID] = m
+	}
+	if len(members) != 2 ||
+		members["u1"] != (domain.TeamMember{ID: "u1", Username: "alice", IsActive: true}) ||
+		members["u2"] != (domain.TeamMember{ID: "u2", Username: "bob", IsActive: false}) {
+		t.Errorf("members = %+v", team.Members)
+	}
+
+	// участник уже в команде — ошибка, а не тихая перезапись
+	if err := r.Teams.AddMembers(ctx, "backend", []domain.TeamMember{{ID: "u1", Username: "alice"}}); err == nil {
+		t.Error("AddMembers with an existing user: want error")
+	}
+
+	u, err := r.Users.Get(ctx, "u1")
+	check(t, err)
+	if u.TeamName != "backend" {
+		t.Errorf("member team = %q, want backend", u.TeamName)
+	}
+}
+
+func testTeamSettings(t *testing.T, r Repositories) {
+	ctx := context.Background()
+
+	_, err := r.Teams.GetSettings(ctx, "backend")
+	wantErr(t, err, repository.ErrTeamNotFound)
+	wantErr(t, r.Teams.SaveSettings(ctx, domain.TeamSettings{TeamName: "backend"}), repository.ErrTeamNotFound)
+
+	seedTeam(t, r, "backend")
+	seedTeam(t, r, "platform")
+
+	_, err = r.Teams.GetSettings(ctx, "backend")
+	wantErr(t, err, repository.ErrNoSettings)
+
+	settings := domain.TeamSettings{
+		TeamName:         "backend",
+		ReviewerStrategy: domain.StrategyLeastLoaded,
+		ReviewerCount:    3,
+		MinApprovals:     2,
+		FallbackTeams:    []string{"platform", domain.AnyTeam},
+		ReviewSLAHours:   24,
+		EscalationHours:  48,
+	}
+	check(t, r.Teams.SaveSettings(ctx, settings))
+	got, err := r.Teams.GetSettings(ctx, "backend")
+	check(t, err)
+	if got.TeamName != settings.TeamName || got.ReviewerStrategy != settings.ReviewerStrategy ||
+		got.ReviewerCount != settings.ReviewerCount || got.MinApprovals != settings.MinApprovals ||
+		got.ReviewSLAHours != settings.ReviewSLAHours || got.EscalationHours != settings.EscalationHours {
+		t.Errorf("settings = %+v, want %+v", got, settings)
+	}
+	// цепочка fallback хранит порядок
+	if len(got.FallbackTeams) != 2 || got.FallbackTeams[0] != "platform" || got.FallbackTeams[1] != domain.AnyTeam {
+		t.Errorf("fallback teams = %v", got.FallbackTeams)
+	}
+
+	// повторное сохранение заменяет настройки целиком
+	check(t, r.Teams.SaveSettings(ctx, domain.TeamSettings{
+		TeamName:         "backend",
+		ReviewerStrategy: domain.StrategyRoundRobin,
+		ReviewerCount:    1,
+	}))
+	got, err = r.Teams.GetSettings(ctx, "backend")
+	check(t, err)
+	if got.ReviewerStrategy != domain.StrategyRoundRobin || got.ReviewerCount != 1 ||
+		got.MinApprovals != 0 || len(got.FallbackTeams) != 0 || got.ReviewSLAHours != 0 {
+		t.Errorf("overwritten settings = %+v", got)
+	}
+
+	_, err = r.Teams.GetSettings(ctx, "platform")
+	wantErr(t, err, repository.ErrNoSettings)
+}
+
+func testCodeowners(t *testing.T, r Repositories) {
+	ctx := context.Background()
+
+	_, err := r.Teams.GetCodeowners(ctx, "backend")
+	wantErr(t, err, repository.ErrTeamNotFound)
+	wantErr(t, r.Teams.SaveCodeowners(ctx, domain.TeamCodeowners{TeamName: "backend"}), repository.ErrTeamNotFound)
+
+	seedTeam(t, r, "backend")
+
+	co, err := r.Teams.GetCodeowners(ctx, "backend")
+	check(t, err)
+	if co.TeamName != "backend" || co.Content != "" {
+		t.Errorf("codeowners before upload = %+v", co)
+	}
+
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	check(t, r.Teams.SaveCodeowners(ctx, domain.TeamCodeowners{TeamName: "backend", Content: "*.go u1\n", UpdatedAt: at}))
+	check(t, r.Teams.SaveCodeowners(ctx, domain.TeamCodeowners{TeamName: "backend", Content: "/api/ u2\n", UpdatedAt: at.Add(time.Hour)}))
+
+	co, err = r.Teams.GetCodeowners(ctx, "backend")
+	check(t, err)
+	if co.Content != "/api/ u2\n" || !co.UpdatedAt.Equal(at.Add(time.Hour)) {
+		t.Errorf("codeowners = %+v", co)
+	}
+}
+
+func testRoundRobin(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	advance := func(queue string, step, want int) {
+		t.Helper()
+		got, err := r.Teams.AdvanceRoundRobin(ctx, queue, step)
+		check(t, err)
+		if got != want {
+			t.Errorf("%s: advance by %d returned %d, want %d", queue, step, got, want)
+		}
+	}
+
+	advance("backend", 2, 0)
+	advance("backend", 1, 2)
+	// у '*' своя очередь
+	advance(domain.AnyTeam, 1, 0)
+	advance("backend", 2, 3)
+
+	// сдвиг в откаченной транзакции не сохраняется
+	errAbort := errors.New("abort")
+	err := r.Tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.Teams.AdvanceRoundRobin(ctx, "backend", 5); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	wantErr(t, err, errAbort)
+	advance("backend", 1, 5)
+}
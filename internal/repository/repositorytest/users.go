@@ -0,0 +1,206 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+func testUsers(t *testing.T, r Repositories) {
+	ctx := context.Background()
+
+	_, err := r.Users.Get(ctx, "u1")
+	wantErr(t, err, domain.ErrUserNotFound)
+	wantErr(t, r.Users.SetActive(ctx, "u1", false), domain.ErrUserNotFound)
+
+	seedTeam(t, r, "backend", "u1", "u2", "u3")
+	seedTeam(t, r, "platform", "p1")
+
+	// Create заменяет существующего пользователя
+	check(t, r.Users.Create(ctx, domain.User{ID: "u1", Username: "alice", TeamName: "platform", IsActive: true}))
+	u, err := r.Users.Get(ctx, "u1")
+	check(t, err)
+	if *u != (domain.User{ID: "u1", Username: "alice", TeamName: "platform", IsActive: true}) {
+		t.Errorf("user = %+v", *u)
+	}
+
+	check(t, r.Users.SetActive(ctx, "u2", false))
+	u, err = r.Users.Get(ctx, "u2")
+	check(t, err)
+	if u.IsActive {
+		t.Error("u2 is still active")
+	}
+
+	active, err := r.Users.GetActiveUsersByTeam(ctx, "backend")
+	check(t, err)
+	equalIDs(t, "active backend users", userIDs(active), "u3")
+
+	check(t, r.Users.SetActive(ctx, "u2", true))
+	check(t, r.Users.DeactivateMany(ctx, []string{"u2", "p1", "missing"}))
+	active, err = r.Users.GetActiveUsersByTeam(ctx, "backend")
+	check(t, err)
+	equalIDs(t, "active backend users after DeactivateMany", userIDs(active), "u3")
+
+	active, err = r.Users.GetActiveUsersByTeam(ctx, "platform")
+	check(t, err)
+	equalIDs(t, "active platform users", userIDs(active), "u1")
+}
+
+func testAbsences(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "u1", "u2")
+	seedTeam(t, r, "platform", "p1")
+
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	vacation, err := r.Users.AddAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start, EndsAt: start.AddDate(0, 0, 7), Reason: "vacation",
+	})
+	check(t, err)
+	if vacation.ID == 0 {
+		t.Error("absence has no id")
+	}
+	sick, err := r.Users.AddAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start.AddDate(0, 0, -3), EndsAt: start.AddDate(0, 0, -1), Reason: "sick",
+	})
+	check(t, err)
+	_, err = r.Users.AddAbsence(ctx, domain.Absence{
+		UserID: "p1", StartsAt: start, EndsAt: start.AddDate(0, 0, 1),
+	})
+	check(t, err)
+
+	// отсутствие — полуинтервал [StartsAt, EndsAt)
+	tests := []struct {
+		at      time.Time
+		backend []string
+		all     []string
+	}{
+		{start.Add(-time.Nanosecond), []string{"u1", "u2"}, []string{"u1", "u2", "p1"}},
+		{start, []string{"u2"}, []string{"u2"}},
+		{start.AddDate(0, 0, 1), []string{"u2"}, []string{"u2", "p1"}},
+		{start.AddDate(0, 0, 7), []string{"u1", "u2"}, []string{"u1", "u2", "p1"}},
+	}
+	for _, tt := range tests {
+		users, err := r.Users.GetAvailableUsersByTeam(ctx, "backend", tt.at)
+		check(t, err)
+		equalIDs(t, "available backend users at "+tt.at.String(), userIDs(users), tt.backend...)
+
+		users, err = r.Users.GetAvailableUsers(ctx, tt.at)
+		check(t, err)
+		equalIDs(t, "available users at "+tt.at.String(), userIDs(users), tt.all...)
+	}
+
+	// неактивные недоступны и без отсутствий
+	check(t, r.Users.SetActive(ctx, "u2", false))
+	users, err := r.Users.GetAvailableUsersByTeam(ctx, "backend", start.Add(-time.Hour))
+	check(t, err)
+	equalIDs(t, "available after deactivation", userIDs(users), "u1")
+
+	list, err := r.Users.ListAbsences(ctx, "u1")
+	check(t, err)
+	if len(list) != 2 || list[0].ID != sick.ID || list[1].ID != vacation.ID {
+		t.Fatalf("absences = %+v, want sick then vacation", list)
+	}
+	if got := list[1]; got.UserID != "u1" || got.Reason != "vacation" ||
+		!got.StartsAt.Equal(vacation.StartsAt) || !got.EndsAt.Equal(vacation.EndsAt) {
+		t.Errorf("absence = %+v, want %+v", got, vacation)
+	}
+
+	// удалить можно только своё отсутствие
+	wantErr(t, r.Users.DeleteAbsence(ctx, "u2", vacation.ID), domain.ErrAbsenceNotFound)
+	check(t, r.Users.DeleteAbsence(ctx, "u1", vacation.ID))
+	wantErr(t, r.Users.DeleteAbsence(ctx, "u1", vacation.ID), domain.ErrAbsenceNotFound)
+
+	list, err = r.Users.ListAbsences(ctx, "u1")
+	check(t, err)
+	if len(list) != 1 || list[0].ID != sick.ID {
+		t.Errorf("absences after delete = %+v", list)
+	}
+}
+
+func testAbsenceUpsert(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "u1", "u2")
+
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	manual, err := r.Users.AddAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start, EndsAt: start.AddDate(0, 0, 1), Reason: "manual",
+	})
+	check(t, err)
+	first, err := r.Users.UpsertAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start, EndsAt: start.AddDate(0, 0, 7), Reason: "vacation", UID: "evt-1",
+	})
+	check(t, err)
+	// тот же UID у другого пользователя — отдельное отсутствие
+	other, err := r.Users.UpsertAbsence(ctx, domain.Absence{
+		UserID: "u2", StartsAt: start, EndsAt: start.AddDate(0, 0, 7), Reason: "vacation", UID: "evt-1",
+	})
+	check(t, err)
+	if first.ID == 0 || first.ID == manual.ID || other.ID == first.ID {
+		t.Fatalf("absence ids: manual %d, u1 %d, u2 %d", manual.ID, first.ID, other.ID)
+	}
+
+	// повторный импорт того же UID обновляет отсутствие на месте
+	moved, err := r.Users.UpsertAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start.AddDate(0, 0, 14), EndsAt: start.AddDate(0, 0, 21), Reason: "vacation, moved", UID: "evt-1",
+	})
+	check(t, err)
+	if moved.ID != first.ID {
+		t.Errorf("upsert id = %d, want %d", moved.ID, first.ID)
+	}
+
+	list, err := r.Users.ListAbsences(ctx, "u1")
+	check(t, err)
+	if len(list) != 2 || list[0].ID != manual.ID || list[1].ID != first.ID {
+		t.Fatalf("absences = %+v, want manual then evt-1", list)
+	}
+	if list[0].UID != "" {
+		t.Errorf("manual absence uid = %q", list[0].UID)
+	}
+	if got := list[1]; got.UID != "evt-1" || got.Reason != "vacation, moved" ||
+		!got.StartsAt.Equal(moved.StartsAt) || !got.EndsAt.Equal(moved.EndsAt) {
+		t.Errorf("upserted absence = %+v, want %+v", got, moved)
+	}
+
+	// ручные отсутствия без UID не сливаются друг с другом
+	_, err = r.Users.AddAbsence(ctx, domain.Absence{
+		UserID: "u1", StartsAt: start, EndsAt: start.AddDate(0, 0, 1), Reason: "manual again",
+	})
+	check(t, err)
+	list, err = r.Users.ListAbsences(ctx, "u1")
+	check(t, err)
+	if len(list) != 3 {
+		t.Errorf("absences after second manual = %+v", list)
+	}
+}
+
+func testExternalLogins(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	seedTeam(t, r, "backend", "u1", "u2")
+
+	_, err := r.Users.ResolveLogin(ctx, domain.ProviderGitHub, "alice")
+	wantErr(t, err, domain.ErrUserNotFound)
+
+	check(t, r.Users.SetExternalLogin(ctx, domain.ExternalLogin{Provider: domain.ProviderGitHub, Login: "alice", UserID: "u1"}))
+	check(t, r.Users.SetExternalLogin(ctx, domain.ExternalLogin{Provider: domain.ProviderGitLab, Login: "alice", UserID: "u2"}))
+
+	id, err := r.Users.ResolveLogin(ctx, domain.ProviderGitHub, "alice")
+	check(t, err)
+	if id != "u1" {
+		t.Errorf("github alice = %q, want u1", id)
+	}
+	id, err = r.Users.ResolveLogin(ctx, domain.ProviderGitLab, "alice")
+	check(t, err)
+	if id != "u2" {
+		t.Errorf("gitlab alice = %q, want u2", id)
+	}
+
+	// повторная привязка логина переносит его на другого пользователя
+	check(t, r.Users.SetExternalLogin(ctx, domain.ExternalLogin{Provider: domain.ProviderGitHub, Login: "alice", UserID: "u2"}))
+	id, err = r.Users.ResolveLogin(ctx, domain.ProviderGitHub, "alice")
+	check(t, err)
+	if id != "u2" {
+		t.Errorf("github alice after rebind = %q, want u2", id)
+	}
+}
@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type assignmentEventRepo struct {
+	db *sql.DB
+}
+
+func NewAssignmentEventRepository(db *sql.DB) repository.AssignmentEventRepository {
+	return &assignmentEventRepo{db: db}
+}
+
+func (r *assignmentEventRepo) Add(ctx context.Context, e domain.AssignmentEvent) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO assignment_events (pull_request_id, user_id, action, reason, detail, actor, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.PRID, e.UserID, e.Action, e.Reason, e.Detail, e.Actor, now(),
+	)
+	return err
+}
+
+func (r *assignmentEventRepo) ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT event_id, pull_request_id, user_id, action, reason, detail, actor, created_at
+		   FROM assignment_events
+		  WHERE pull_request_id=?
+		  ORDER BY event_id`,
+		prID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.AssignmentEvent
+	for rows.Next() {
+		var e domain.AssignmentEvent
+		if err := rows.Scan(&e.ID, &e.PRID, &e.UserID, &e.Action, &e.Reason,
+			&e.Detail, &e.Actor, timeCol{&e.CreatedAt}); err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,130 @@
+// Package sqlite — реализации репозиториев на SQLite (database/sql,
+// драйвер modernc.org/sqlite) для запуска сервиса без PostgreSQL.
+// Схема — migrations/sqlite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"pr-reviewer-service/internal/repository"
+)
+
+// DB — общее у *sql.DB и *sql.Tx.
+type DB interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+type transactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(db *sql.DB) repository.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Вложенный вызов присоединяется к уже открытой транзакции
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // после Commit это no-op
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// conn возвращает транзакцию из ctx, если она открыта, иначе db.
+func conn(ctx context.Context, db *sql.DB) DB {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// ----------------- TIME -----------------
+
+// timeLayout — фиксированная ширина: строки сравниваются в SQL
+// так же, как моменты времени.
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// nullTime — NULL для nil.
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return formatTime(*t)
+}
+
+// now — время записи, как NOW() в Postgres.
+func now() string {
+	return formatTime(time.Now())
+}
+
+// timeCol сканирует TEXT-колонку со временем в *time.Time.
+type timeCol struct {
+	dst *time.Time
+}
+
+func (c timeCol) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("time column is not TEXT")
+	}
+	t, err := time.Parse(timeLayout, s)
+	*c.dst = t
+	return err
+}
+
+// nullTimeCol сканирует TEXT-колонку со временем, NULL — nil.
+type nullTimeCol struct {
+	dst **time.Time
+}
+
+func (c nullTimeCol) Scan(src any) error {
+	if src == nil {
+		*c.dst = nil
+		return nil
+	}
+	var t time.Time
+	if err := (timeCol{&t}).Scan(src); err != nil {
+		return err
+	}
+	*c.dst = &t
+	return nil
+}
+
+// nullPeriod — границы периода фильтра для (? IS NULL OR ...).
+func nullPeriod(from, to *time.Time) (any, any) {
+	return nullTime(from), nullTime(to)
+}
+
+// ----------------- LISTS -----------------
+
+// jsonList — список для json_each(?) вместо ANY($1) и для TEXT-колонок
+// со списками.
+func jsonList(items []string) string {
+	if items == nil {
+		items = []string{}
+	}
+	b, _ := json.Marshal(items)
+	return string(b)
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type escalationRepo struct {
+	db *sql.DB
+}
+
+func NewEscalationRepository(db *sql.DB) repository.EscalationRepository {
+	return &escalationRepo{db: db}
+}
+
+// Overdue выбирает назначения без решения у команд с SLA; срок сверяется
+// в Go — интервалов над TEXT-временем в SQLite нет.
+func (r *escalationRepo) Overdue(ctx context.Context, now time.Time) ([]domain.OverdueReview, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT prr.pull_request_id, prr.user_id, u.team_name, prr.assigned_at,
+		       ts.review_sla_hours, ts.escalation_hours,
+		       EXISTS (
+		           SELECT 1 FROM review_escalations e
+		            WHERE e.pull_request_id = prr.pull_request_id
+		              AND e.user_id = prr.user_id
+		              AND e.assigned_at = prr.assigned_at
+		              AND e.kind = 'reminder'
+		       )
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = pr.author_id
+		JOIN team_settings ts ON ts.team_name = u.team_name
+		WHERE pr.status = 'OPEN'
+		  AND prr.first_decided_at IS NULL
+		  AND ts.review_sla_hours > 0
+		ORDER BY prr.assigned_at
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.OverdueReview
+	for rows.Next() {
+		var o domain.OverdueReview
+		var slaHours, escalationHours int
+		if err := rows.Scan(&o.PRID, &o.ReviewerID, &o.TeamName, timeCol{&o.AssignedAt},
+			&slaHours, &escalationHours, &o.Reminded); err != nil {
+			return nil, err
+		}
+		o.SLA = time.Duration(slaHours) * time.Hour
+		o.EscalateAfter = time.Duration(escalationHours) * time.Hour
+		if now.Sub(o.AssignedAt) >= o.SLA {
+			result = append(result, o)
+		}
+	}
+	return result, rows.Err()
+}
+
+func (r *escalationRepo) Record(ctx context.Context, e domain.Escalation) (domain.Escalation, error) {
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO review_escalations (pull_request_id, user_id, kind, assigned_at, replaced_by, reason, created_at)
+		 VALUES (?, ?, ?, ?, NULLIF(?, ''), ?, ?)
+		 RETURNING escalation_id, created_at`,
+		e.PRID, e.ReviewerID, e.Kind, formatTime(e.AssignedAt), e.ReplacedBy, e.Reason, now(),
+	).Scan(&e.ID, timeCol{&e.CreatedAt})
+	return e, err
+}
+
+func (r *escalationRepo) ListByPR(ctx context.Context, prID string) ([]domain.Escalation, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT escalation_id, pull_request_id, user_id, kind, assigned_at,
+		        COALESCE(replaced_by, ''), reason, created_at
+		   FROM review_escalations
+		  WHERE pull_request_id=?
+		  ORDER BY created_at, escalation_id`,
+		prID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.Escalation
+	for rows.Next() {
+		var e domain.Escalation
+		if err := rows.Scan(&e.ID, &e.PRID, &e.ReviewerID, &e.Kind, timeCol{&e.AssignedAt},
+			&e.ReplacedBy, &e.Reason, timeCol{&e.CreatedAt}); err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type outboxRepo struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) repository.OutboxRepository {
+	return &outboxRepo{db: db}
+}
+
+func (r *outboxRepo) Add(ctx context.Context, n domain.Notification) error {
+	if n.At.IsZero() {
+		n.At = time.Now().UTC()
+	}
+	n.ID = "" // выдаётся базой
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	at := now()
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO outbox (kind, payload, created_at, next_attempt_at) VALUES (?, ?, ?, ?)`,
+		n.Kind, string(payload), at, at,
+	)
+	return err
+}
+
+// LeasePending без FOR UPDATE SKIP LOCKED: транзакции SQLite и так
+// выполняются по одной. RETURNING не сохраняет порядок, поэтому
+// записи сортируются после чтения.
+func (r *outboxRepo) LeasePending(ctx context.Context, now, until time.Time, limit int) ([]domain.OutboxEvent, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		UPDATE outbox SET next_attempt_at=?1
+		 WHERE event_id IN (
+			SELECT event_id
+			  FROM outbox
+			 WHERE sent_at IS NULL AND dead_at IS NULL AND next_attempt_at <= ?2
+			 ORDER BY event_id
+			 LIMIT ?3
+		 )
+		RETURNING event_id, payload, attempts
+	`, formatTime(until), formatTime(now), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type leased struct {
+		id int64
+		e  domain.OutboxEvent
+	}
+	var batch []leased
+	for rows.Next() {
+		var (
+			l       leased
+			payload string
+		)
+		if err := rows.Scan(&l.id, &payload, &l.e.Attempts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(payload), &l.e.Notification); err != nil {
+			return nil, err
+		}
+		l.e.ID = strconv.FormatInt(l.id, 10)
+		batch = append(batch, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(batch, func(a, b leased) int { return cmp.Compare(a.id, b.id) })
+	result := make([]domain.OutboxEvent, len(batch))
+	for i, l := range batch {
+		result[i] = l.e
+	}
+	return result, nil
+}
+
+func (r *outboxRepo) MarkSent(ctx context.Context, id string) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE outbox SET sent_at=?, last_error=NULL WHERE event_id=?`,
+		now(), eventID,
+	)
+	return err
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, id string, reason string, next time.Time) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE outbox SET attempts=attempts+1, last_error=?, next_attempt_at=? WHERE event_id=?`,
+		reason, formatTime(next), eventID,
+	)
+	return err
+}
+
+func (r *outboxRepo) MarkDead(ctx context.Context, id string, reason string) error {
+	eventID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE outbox SET attempts=attempts+1, last_error=?, dead_at=? WHERE event_id=?`,
+		reason, now(), eventID,
+	)
+	return err
+}
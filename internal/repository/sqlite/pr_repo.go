@@ -0,0 +1,453 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type prRepo struct {
+	db *sql.DB
+}
+
+func NewPRRepository(db *sql.DB) repository.PRRepository {
+	return &prRepo{db: db}
+}
+
+func (r *prRepo) Create(ctx context.Context, pr domain.PullRequest) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at)
+		 VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT (pull_request_id) DO NOTHING`,
+		pr.ID, pr.Name, pr.AuthorID, pr.Status, formatTime(pr.CreatedAt),
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return domain.ErrPRExists
+	}
+
+	for _, path := range pr.Files {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO pull_request_files (pull_request_id, path) VALUES (?, ?) ON CONFLICT DO NOTHING`,
+			pr.ID, path,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *prRepo) AddReviewer(ctx context.Context, prID string, reviewer domain.Review) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO pull_request_reviewers (pull_request_id, user_id, fallback_team, assigned_at)
+		 VALUES (?, ?, NULLIF(?, ''), ?)
+		 ON CONFLICT DO NOTHING`,
+		prID, reviewer.ReviewerID, reviewer.FallbackTeam, now(),
+	)
+	return err
+}
+
+func (r *prRepo) Get(ctx context.Context, prID string) (domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, closed_at
+		   FROM pull_requests
+		  WHERE pull_request_id=?`,
+		prID,
+	).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status,
+		timeCol{&pr.CreatedAt}, nullTimeCol{&pr.MergedAt}, nullTimeCol{&pr.ClosedAt})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PullRequest{}, domain.ErrPRNotFound
+	}
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT user_id, COALESCE(decision, ''), decided_at, COALESCE(fallback_team, '')
+		   FROM pull_request_reviewers
+		  WHERE pull_request_id=?
+		  ORDER BY rowid`,
+		prID,
+	)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rv domain.Review
+		if err := rows.Scan(&rv.ReviewerID, &rv.Decision, nullTimeCol{&rv.DecidedAt}, &rv.FallbackTeam); err != nil {
+			return domain.PullRequest{}, err
+		}
+		pr.Reviewers = append(pr.Reviewers, rv.ReviewerID)
+		pr.Reviews = append(pr.Reviews, rv)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	fileRows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT path FROM pull_request_files WHERE pull_request_id=? ORDER BY path`,
+		prID,
+	)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	defer fileRows.Close()
+
+	for fileRows.Next() {
+		var path string
+		if err := fileRows.Scan(&path); err != nil {
+			return domain.PullRequest{}, err
+		}
+		pr.Files = append(pr.Files, path)
+	}
+	return pr, fileRows.Err()
+}
+
+// exec выполняет изменение и возвращает notFound, если оно не затронуло строк.
+func (r *prRepo) exec(ctx context.Context, notFound error, query string, args ...any) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func (r *prRepo) Merge(ctx context.Context, prID string) error {
+	return r.exec(ctx, domain.ErrPRNotFound,
+		`UPDATE pull_requests SET status='MERGED', merged_at=? WHERE pull_request_id=?`,
+		now(), prID,
+	)
+}
+
+func (r *prRepo) SetStatus(ctx context.Context, prID string, status domain.PRStatus) error {
+	return r.exec(ctx, domain.ErrPRNotFound,
+		`UPDATE pull_requests
+		    SET status=?2,
+		        closed_at=CASE WHEN ?2='CLOSED' THEN ?3 END
+		  WHERE pull_request_id=?1`,
+		prID, status, now(),
+	)
+}
+
+func (r *prRepo) ReplaceReviewer(ctx context.Context, prID, oldUser string, newReviewer domain.Review) error {
+	return r.exec(ctx, repository.ErrReviewerNotFound,
+		`UPDATE pull_request_reviewers
+		    SET user_id=?3, fallback_team=NULLIF(?4, ''), decision=NULL, decided_at=NULL,
+		        assigned_at=?5, first_decided_at=NULL
+		  WHERE pull_request_id=?1 AND user_id=?2`,
+		prID, oldUser, newReviewer.ReviewerID, newReviewer.FallbackTeam, now(),
+	)
+}
+
+func (r *prRepo) GetForReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
+		   FROM pull_requests pr
+		   JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+		  WHERE prr.user_id=?
+		  ORDER BY pr.rowid`,
+		reviewerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.PullRequestShort
+	for rows.Next() {
+		var p domain.PullRequestShort
+		if err := rows.Scan(&p.ID, &p.Name, &p.AuthorID, &p.Status); err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
+
+// Параметры фильтра статистики во всех запросах ниже: ?1 — команда,
+// ?2 и ?3 — границы периода (NULL — без границы).
+
+func (r *prRepo) Stats(ctx context.Context, filter domain.StatsFilter) (domain.Stats, error) {
+	from, to := nullPeriod(filter.From, filter.To)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT u.user_id, u.team_name,
+		       COUNT(*) FILTER (WHERE s.kind = 'review'),
+		       COUNT(*) FILTER (WHERE s.kind = 'review' AND s.status = 'OPEN'),
+		       COUNT(*) FILTER (WHERE s.kind = 'review' AND s.status = 'MERGED'),
+		       COUNT(*) FILTER (WHERE s.kind = 'author')
+		FROM (
+			SELECT prr.user_id, 'review' AS kind, pr.status
+			FROM pull_request_reviewers prr
+			JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+			WHERE (?2 IS NULL OR pr.created_at >= ?2)
+			  AND (?3 IS NULL OR pr.created_at < ?3)
+			UNION ALL
+			SELECT pr.author_id, 'author', pr.status
+			FROM pull_requests pr
+			WHERE (?2 IS NULL OR pr.created_at >= ?2)
+			  AND (?3 IS NULL OR pr.created_at < ?3)
+		) s
+		JOIN users u ON u.user_id = s.user_id
+		WHERE ?1 = '' OR u.team_name = ?1
+		GROUP BY u.user_id, u.team_name
+		ORDER BY u.team_name, u.user_id
+	`, filter.Team, from, to)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+	defer rows.Close()
+
+	var stats domain.Stats
+	for rows.Next() {
+		var us domain.UserStats
+		if err := rows.Scan(&us.UserID, &us.TeamName,
+			&us.Assignments, &us.OpenAssignments, &us.MergedAssignments, &us.AuthoredPRs); err != nil {
+			return domain.Stats{}, err
+		}
+		stats.Users = append(stats.Users, us)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.Stats{}, err
+	}
+
+	rows2, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT pr.status, COUNT(*)
+		FROM pull_requests pr
+		JOIN users u ON u.user_id = pr.author_id
+		WHERE (?1 = '' OR u.team_name = ?1)
+		  AND (?2 IS NULL OR pr.created_at >= ?2)
+		  AND (?3 IS NULL OR pr.created_at < ?3)
+		GROUP BY pr.status
+	`, filter.Team, from, to)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+	defer rows2.Close()
+
+	stats.PRStatus = map[domain.PRStatus]int{}
+	for rows2.Next() {
+		var status domain.PRStatus
+		var cnt int
+		if err := rows2.Scan(&status, &cnt); err != nil {
+			return domain.Stats{}, err
+		}
+		stats.PRStatus[status] = cnt
+	}
+	return stats, rows2.Err()
+}
+
+// Turnaround — те же выборки, что и в PostgreSQL; длительности считаются
+// в Go, потому что время хранится строками.
+func (r *prRepo) Turnaround(ctx context.Context, filter domain.StatsFilter) (domain.TurnaroundSamples, error) {
+	var res domain.TurnaroundSamples
+	var err error
+
+	res.Merges, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, pr.created_at, pr.merged_at
+		FROM pull_requests pr
+		JOIN users u ON u.user_id = pr.author_id
+		WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+		  AND (?1 = '' OR u.team_name = ?1)
+		  AND (?2 IS NULL OR pr.created_at >= ?2)
+		  AND (?3 IS NULL OR pr.created_at < ?3)
+	`, filter)
+	if err != nil {
+		return res, err
+	}
+
+	res.ReviewerMerges, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, pr.created_at, pr.merged_at
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = prr.user_id
+		WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+		  AND (?1 = '' OR u.team_name = ?1)
+		  AND (?2 IS NULL OR pr.created_at >= ?2)
+		  AND (?3 IS NULL OR pr.created_at < ?3)
+	`, filter)
+	if err != nil {
+		return res, err
+	}
+
+	res.Reviews, err = r.durations(ctx, `
+		SELECT u.user_id, u.team_name, prr.assigned_at, prr.first_decided_at
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		JOIN users u ON u.user_id = prr.user_id
+		WHERE prr.first_decided_at IS NOT NULL
+		  AND (?1 = '' OR u.team_name = ?1)
+		  AND (?2 IS NULL OR pr.created_at >= ?2)
+		  AND (?3 IS NULL OR pr.created_at < ?3)
+	`, filter)
+	return res, err
+}
+
+// durations выполняет запрос вида (user_id, team_name, начало, конец).
+func (r *prRepo) durations(ctx context.Context, query string, filter domain.StatsFilter) ([]domain.DurationSample, error) {
+	from, to := nullPeriod(filter.From, filter.To)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, filter.Team, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []domain.DurationSample
+	for rows.Next() {
+		var x domain.DurationSample
+		var start, end time.Time
+		if err := rows.Scan(&x.UserID, &x.TeamName, timeCol{&start}, timeCol{&end}); err != nil {
+			return nil, err
+		}
+		x.Duration = end.Sub(start)
+		res = append(res, x)
+	}
+	return res, rows.Err()
+}
+
+// AssignmentCounts группирует назначения по неделям в Go: date_trunc
+// в SQLite нет.
+func (r *prRepo) AssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.AssignmentCount, error) {
+	from, to := nullPeriod(filter.From, filter.To)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT u.user_id, u.team_name, prr.assigned_at
+		FROM users u
+		LEFT JOIN pull_request_reviewers prr
+		       ON prr.user_id = u.user_id
+		      AND (?2 IS NULL OR prr.assigned_at >= ?2)
+		      AND (?3 IS NULL OR prr.assigned_at < ?3)
+		WHERE (?1 = '' OR u.team_name = ?1)
+		  AND (u.is_active OR prr.user_id IS NOT NULL)
+	`, filter.Team, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type key struct {
+		userID, team string
+		week         time.Time
+	}
+	counts := map[key]int{}
+	for rows.Next() {
+		var k key
+		var assignedAt *time.Time
+		if err := rows.Scan(&k.userID, &k.team, nullTimeCol{&assignedAt}); err != nil {
+			return nil, err
+		}
+		if assignedAt == nil {
+			counts[k] += 0
+			continue
+		}
+		k.week = domain.WeekStart(*assignedAt)
+		counts[k]++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.AssignmentCount, 0, len(counts))
+	for k, n := range counts {
+		res = append(res, domain.AssignmentCount{UserID: k.userID, TeamName: k.team, Week: k.week, Count: n})
+	}
+	slices.SortFunc(res, func(a, b domain.AssignmentCount) int {
+		return cmp.Or(cmp.Compare(a.TeamName, b.TeamName), cmp.Compare(a.UserID, b.UserID), a.Week.Compare(b.Week))
+	})
+	return res, nil
+}
+
+func (r *prRepo) OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT prr.user_id, COUNT(*)
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.status = 'OPEN'
+		  AND prr.user_id IN (SELECT value FROM json_each(?))
+		GROUP BY prr.user_id
+	`, jsonList(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var id string
+		var cnt int
+		if err := rows.Scan(&id, &cnt); err != nil {
+			return nil, err
+		}
+		counts[id] = cnt
+	}
+	return counts, rows.Err()
+}
+
+func (r *prRepo) GetOpenByReviewers(ctx context.Context, userIDs []string) ([]string, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT DISTINCT prr.pull_request_id
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE pr.status = 'OPEN'
+		  AND prr.user_id IN (SELECT value FROM json_each(?))
+	`, jsonList(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		prIDs = append(prIDs, id)
+	}
+	return prIDs, rows.Err()
+}
+
+func (r *prRepo) RemoveReviewer(ctx context.Context, prID, userID string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM pull_request_reviewers WHERE pull_request_id=? AND user_id=?`,
+		prID, userID,
+	)
+	return err
+}
+
+func (r *prRepo) SetDecision(ctx context.Context, prID, userID string, decision domain.ReviewDecision) error {
+	return r.exec(ctx, domain.ErrNotAssigned,
+		`UPDATE pull_request_reviewers
+		    SET decision=?3, decided_at=?4, first_decided_at=COALESCE(first_decided_at, ?4)
+		  WHERE pull_request_id=?1 AND user_id=?2`,
+		prID, userID, decision, now(),
+	)
+}
+
+func (r *prRepo) RemoveReviewers(ctx context.Context, prID string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM pull_request_reviewers WHERE pull_request_id=?`,
+		prID,
+	)
+	return err
+}
@@ -0,0 +1,35 @@
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"pr-reviewer-service/internal/repository/repositorytest"
+	"pr-reviewer-service/internal/repository/sqlite"
+	"pr-reviewer-service/internal/storage"
+)
+
+func TestRepositories(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		ctx := context.Background()
+		db, err := storage.NewSQLite(ctx, "sqlite://"+filepath.Join(t.TempDir(), "test.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(db.Close)
+		if err := storage.ApplySQLiteMigrations(ctx, db.DB); err != nil {
+			t.Fatal(err)
+		}
+
+		return repositorytest.Repositories{
+			Teams:       sqlite.NewTeamRepository(db.DB),
+			Users:       sqlite.NewUserRepository(db.DB),
+			PRs:         sqlite.NewPRRepository(db.DB),
+			Escalations: sqlite.NewEscalationRepository(db.DB),
+			Outbox:      sqlite.NewOutboxRepository(db.DB),
+			Events:      sqlite.NewAssignmentEventRepository(db.DB),
+			Tx:          sqlite.NewTransactor(db.DB),
+		}
+	})
+}
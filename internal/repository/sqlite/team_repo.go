@@ -0,0 +1,198 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type teamRepo struct {
+	db *sql.DB
+}
+
+func NewTeamRepository(db *sql.DB) repository.TeamRepository {
+	return &teamRepo{db: db}
+}
+
+func (r *teamRepo) Create(ctx context.Context, name string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO teams (name) VALUES (?) ON CONFLICT (name) DO NOTHING`,
+		name,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrTeamExists
+	}
+	return nil
+}
+
+func (r *teamRepo) Get(ctx context.Context, teamName string) (*domain.Team, error) {
+	exists, err := r.exists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, repository.ErrTeamNotFound
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT user_id, username, is_active FROM users WHERE team_name=? ORDER BY rowid`,
+		teamName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	team := &domain.Team{Name: teamName}
+	for rows.Next() {
+		var m domain.TeamMember
+		if err := rows.Scan(&m.ID, &m.Username, &m.IsActive); err != nil {
+			return nil, err
+		}
+		team.Members = append(team.Members, m)
+	}
+	return team, rows.Err()
+}
+
+func (r *teamRepo) AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) error {
+	for _, m := range members {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO users (user_id, username, team_name, is_active) VALUES (?, ?, ?, ?)`,
+			m.ID, m.Username, teamName, m.IsActive,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *teamRepo) exists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM teams WHERE name=?)`,
+		teamName,
+	).Scan(&exists)
+	return exists, err
+}
+
+func (r *teamRepo) GetSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	settings := domain.TeamSettings{TeamName: teamName}
+
+	var fallback string
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT reviewer_strategy, reviewer_count, min_approvals, fallback_teams,
+		        review_sla_hours, escalation_hours
+		   FROM team_settings WHERE team_name=?`,
+		teamName,
+	).Scan(&settings.ReviewerStrategy, &settings.ReviewerCount, &settings.MinApprovals, &fallback,
+		&settings.ReviewSLAHours, &settings.EscalationHours)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		exists, err := r.exists(ctx, teamName)
+		if err != nil {
+			return domain.TeamSettings{}, err
+		}
+		if !exists {
+			return domain.TeamSettings{}, repository.ErrTeamNotFound
+		}
+		return domain.TeamSettings{}, repository.ErrNoSettings
+	}
+	if err != nil {
+		return domain.TeamSettings{}, err
+	}
+
+	if err := json.Unmarshal([]byte(fallback), &settings.FallbackTeams); err != nil {
+		return domain.TeamSettings{}, err
+	}
+	return settings, nil
+}
+
+func (r *teamRepo) SaveSettings(ctx context.Context, settings domain.TeamSettings) error {
+	exists, err := r.exists(ctx, settings.TeamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return repository.ErrTeamNotFound
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO team_settings (team_name, reviewer_strategy, reviewer_count, min_approvals, fallback_teams,
+		                           review_sla_hours, escalation_hours)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (team_name)
+		DO UPDATE SET reviewer_strategy=excluded.reviewer_strategy,
+		              reviewer_count=excluded.reviewer_count,
+		              min_approvals=excluded.min_approvals,
+		              fallback_teams=excluded.fallback_teams,
+		              review_sla_hours=excluded.review_sla_hours,
+		              escalation_hours=excluded.escalation_hours
+	`, settings.TeamName, settings.ReviewerStrategy, settings.ReviewerCount, settings.MinApprovals,
+		jsonList(settings.FallbackTeams), settings.ReviewSLAHours, settings.EscalationHours)
+	return err
+}
+
+func (r *teamRepo) GetCodeowners(ctx context.Context, teamName string) (domain.TeamCodeowners, error) {
+	co := domain.TeamCodeowners{TeamName: teamName}
+
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT content, updated_at FROM team_codeowners WHERE team_name=?`,
+		teamName,
+	).Scan(&co.Content, timeCol{&co.UpdatedAt})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		exists, err := r.exists(ctx, teamName)
+		if err != nil {
+			return domain.TeamCodeowners{}, err
+		}
+		if !exists {
+			return domain.TeamCodeowners{}, repository.ErrTeamNotFound
+		}
+		return co, nil
+	}
+	if err != nil {
+		return domain.TeamCodeowners{}, err
+	}
+	return co, nil
+}
+
+func (r *teamRepo) SaveCodeowners(ctx context.Context, co domain.TeamCodeowners) error {
+	exists, err := r.exists(ctx, co.TeamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return repository.ErrTeamNotFound
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO team_codeowners (team_name, content, updated_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT (team_name)
+		DO UPDATE SET content=excluded.content,
+		              updated_at=excluded.updated_at
+	`, co.TeamName, co.Content, formatTime(co.UpdatedAt))
+	return err
+}
+
+func (r *teamRepo) AdvanceRoundRobin(ctx context.Context, queue string, step int) (int, error) {
+	var prev int
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		INSERT INTO round_robin_cursors (queue, next_index)
+		VALUES (?, ?)
+		ON CONFLICT (queue)
+		DO UPDATE SET next_index=round_robin_cursors.next_index + excluded.next_index
+		RETURNING next_index - ?
+	`, queue, step, step).Scan(&prev)
+	return prev, err
+}
@@ -0,0 +1,211 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+type userRepo struct {
+	db *sql.DB
+}
+
+func NewUserRepository(db *sql.DB) repository.UserRepository {
+	return &userRepo{db: db}
+}
+
+func (r *userRepo) Create(ctx context.Context, user domain.User) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO users (user_id, username, team_name, is_active)
+		 VALUES (?, ?, ?, ?)
+		 ON CONFLICT (user_id)
+		 DO UPDATE SET username=excluded.username,
+		               team_name=excluded.team_name,
+		               is_active=excluded.is_active`,
+		user.ID, user.Username, user.TeamName, user.IsActive,
+	)
+	return err
+}
+
+func (r *userRepo) SetActive(ctx context.Context, userID string, active bool) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE users SET is_active=? WHERE user_id=?`,
+		active, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepo) Get(ctx context.Context, userID string) (*domain.User, error) {
+	var u domain.User
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT user_id, username, team_name, is_active FROM users WHERE user_id=?`,
+		userID,
+	).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrUserNotFound
+	}
+	return &u, err
+}
+
+func (r *userRepo) GetActiveUsersByTeam(ctx context.Context, team string) ([]domain.User, error) {
+	return r.queryUsers(ctx,
+		`SELECT user_id, username, team_name, is_active
+		   FROM users
+		  WHERE team_name=? AND is_active
+		  ORDER BY rowid`,
+		team,
+	)
+}
+
+func (r *userRepo) DeactivateMany(ctx context.Context, ids []string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE users SET is_active=0 WHERE user_id IN (SELECT value FROM json_each(?))`,
+		jsonList(ids),
+	)
+	return err
+}
+
+func (r *userRepo) GetAvailableUsersByTeam(ctx context.Context, team string, at time.Time) ([]domain.User, error) {
+	return r.queryUsers(ctx,
+		`SELECT u.user_id, u.username, u.team_name, u.is_active
+		   FROM users u
+		  WHERE u.team_name=?1 AND u.is_active
+		    AND NOT EXISTS (
+		        SELECT 1 FROM user_absences a
+		         WHERE a.user_id = u.user_id
+		           AND a.starts_at <= ?2 AND a.ends_at > ?2)
+		  ORDER BY u.rowid`,
+		team, formatTime(at),
+	)
+}
+
+func (r *userRepo) GetAvailableUsers(ctx context.Context, at time.Time) ([]domain.User, error) {
+	return r.queryUsers(ctx,
+		`SELECT u.user_id, u.username, u.team_name, u.is_active
+		   FROM users u
+		  WHERE u.is_active
+		    AND NOT EXISTS (
+		        SELECT 1 FROM user_absences a
+		         WHERE a.user_id = u.user_id
+		           AND a.starts_at <= ?1 AND a.ends_at > ?1)
+		  ORDER BY u.rowid`,
+		formatTime(at),
+	)
+}
+
+func (r *userRepo) queryUsers(ctx context.Context, query string, args ...any) ([]domain.User, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.User
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			return nil, err
+		}
+		result = append(result, u)
+	}
+	return result, rows.Err()
+}
+
+func (r *userRepo) AddAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO user_absences (user_id, starts_at, ends_at, reason)
+		 VALUES (?, ?, ?, ?)
+		 RETURNING absence_id`,
+		a.UserID, formatTime(a.StartsAt), formatTime(a.EndsAt), a.Reason,
+	).Scan(&a.ID)
+	return a, err
+}
+
+func (r *userRepo) UpsertAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO user_absences (user_id, starts_at, ends_at, reason, uid)
+		 VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT (user_id, uid)
+		 DO UPDATE SET starts_at=excluded.starts_at, ends_at=excluded.ends_at, reason=excluded.reason
+		 RETURNING absence_id`,
+		a.UserID, formatTime(a.StartsAt), formatTime(a.EndsAt), a.Reason, a.UID,
+	).Scan(&a.ID)
+	return a, err
+}
+
+func (r *userRepo) ListAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT absence_id, user_id, starts_at, ends_at, reason, COALESCE(uid, '')
+		   FROM user_absences
+		  WHERE user_id=?
+		  ORDER BY starts_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.Absence
+	for rows.Next() {
+		var a domain.Absence
+		if err := rows.Scan(&a.ID, &a.UserID, timeCol{&a.StartsAt}, timeCol{&a.EndsAt}, &a.Reason, &a.UID); err != nil {
+			return nil, err
+		}
+		result = append(result, a)
+	}
+	return result, rows.Err()
+}
+
+func (r *userRepo) DeleteAbsence(ctx context.Context, userID string, absenceID int64) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM user_absences WHERE absence_id=? AND user_id=?`,
+		absenceID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return domain.ErrAbsenceNotFound
+	}
+	return nil
+}
+
+func (r *userRepo) SetExternalLogin(ctx context.Context, l domain.ExternalLogin) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO external_logins (provider, login, user_id)
+		 VALUES (?, ?, ?)
+		 ON CONFLICT (provider, login)
+		 DO UPDATE SET user_id=excluded.user_id`,
+		l.Provider, l.Login, l.UserID,
+	)
+	return err
+}
+
+func (r *userRepo) ResolveLogin(ctx context.Context, provider domain.Provider, login string) (string, error) {
+	var userID string
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT user_id FROM external_logins WHERE provider=? AND login=?`,
+		provider, login,
+	).Scan(&userID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrUserNotFound
+	}
+	return userID, err
+}
@@ -22,6 +22,18 @@ type TeamRepository interface {
 	Get(ctx context.Context, name string) (*domain.Team, error)
 
 	AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) error
+
+	// GetSettings возвращает ErrNoSettings, если команда настроек не сохраняла.
+	GetSettings(ctx context.Context, teamName string) (domain.TeamSettings, error)
+	SaveSettings(ctx context.Context, settings domain.TeamSettings) error
+
+	GetCodeowners(ctx context.Context, teamName string) (domain.TeamCodeowners, error)
+	SaveCodeowners(ctx context.Context, co domain.TeamCodeowners) error
+
+	// AdvanceRoundRobin сдвигает позицию очереди round-robin на step
+	// и возвращает прежнюю (у новой очереди — 0). queue — имя команды,
+	// '*' из цепочки fallback или очередь владельцев из CODEOWNERS.
+	AdvanceRoundRobin(ctx context.Context, queue string, step int) (int, error)
 }
 
 type teamRepo struct {
@@ -34,31 +46,25 @@ func NewTeamRepository(db DB) TeamRepository {
 
 var ErrTeamExists = errors.New("team already exists")
 var ErrTeamNotFound = errors.New("team not found")
+var ErrNoSettings = errors.New("team settings not saved")
 
 func (r *teamRepo) Create(ctx context.Context, name string) error {
-	var exists bool
-	err := r.db.QueryRow(ctx,
-		`SELECT EXISTS(SELECT 1 FROM teams WHERE name=$1)`,
+	tag, err := conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO teams (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`,
 		name,
-	).Scan(&exists)
-
+	)
 	if err != nil {
 		return err
 	}
-	if exists {
+	if tag.RowsAffected() == 0 {
 		return ErrTeamExists
 	}
-
-	_, err = r.db.Exec(ctx,
-		`INSERT INTO teams (name) VALUES ($1)`,
-		name,
-	)
-	return err
+	return nil
 }
 
 func (r *teamRepo) Get(ctx context.Context, teamName string) (*domain.Team, error) {
 
-	rows, err := r.db.Query(ctx,
+	rows, err := conn(ctx, r.db).Query(ctx,
 		`SELECT user_id, username, is_active FROM users WHERE team_name=$1`,
 		teamName,
 	)
@@ -79,7 +85,7 @@ func (r *teamRepo) Get(ctx context.Context, teamName string) (*domain.Team, erro
 	if len(members) == 0 {
 		// Проверяем, существует ли команда
 		var exists bool
-		err = r.db.QueryRow(ctx,
+		err = conn(ctx, r.db).QueryRow(ctx,
 			`SELECT EXISTS(SELECT 1 FROM teams WHERE name=$1)`,
 			teamName,
 		).Scan(&exists)
@@ -99,7 +105,7 @@ func (r *teamRepo) Get(ctx context.Context, teamName string) (*domain.Team, erro
 }
 func (r *teamRepo) AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) error {
 	for _, m := range members {
-		_, err := r.db.Exec(ctx, `
+		_, err := conn(ctx, r.db).Exec(ctx, `
 			INSERT INTO users (user_id, username, team_name, is_active)
 			VALUES ($1, $2, $3, $4)
 		`, m.ID, m.Username, teamName, m.IsActive)
@@ -110,3 +116,131 @@ func (r *teamRepo) AddMembers(ctx context.Context, teamName string, members []do
 	}
 	return nil
 }
+
+func (r *teamRepo) exists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM teams WHERE name=$1)`,
+		teamName,
+	).Scan(&exists)
+	return exists, err
+}
+
+func (r *teamRepo) GetSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	settings := domain.TeamSettings{TeamName: teamName}
+
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`SELECT reviewer_strategy, reviewer_count, min_approvals, fallback_teams,
+		        review_sla_hours, escalation_hours
+		   FROM team_settings WHERE team_name=$1`,
+		teamName,
+	).Scan(&settings.ReviewerStrategy, &settings.ReviewerCount, &settings.MinApprovals, &settings.FallbackTeams,
+		&settings.ReviewSLAHours, &settings.EscalationHours)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Настроек нет — различаем «не сохраняли» и «нет такой команды»
+		exists, err := r.exists(ctx, teamName)
+		if err != nil {
+			return domain.TeamSettings{}, err
+		}
+		if !exists {
+			return domain.TeamSettings{}, ErrTeamNotFound
+		}
+		return domain.TeamSettings{}, ErrNoSettings
+	}
+	if err != nil {
+		return domain.TeamSettings{}, err
+	}
+
+	return settings, nil
+}
+
+func (r *teamRepo) SaveSettings(ctx context.Context, settings domain.TeamSettings) error {
+	exists, err := r.exists(ctx, settings.TeamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrTeamNotFound
+	}
+
+	_, err = conn(ctx, r.db).Exec(ctx, `
+		INSERT INTO team_settings (team_name, reviewer_strategy, reviewer_count, min_approvals, fallback_teams,
+		                           review_sla_hours, escalation_hours)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (team_name)
+		DO UPDATE SET reviewer_strategy=EXCLUDED.reviewer_strategy,
+		              reviewer_count=EXCLUDED.reviewer_count,
+		              min_approvals=EXCLUDED.min_approvals,
+		              fallback_teams=EXCLUDED.fallback_teams,
+		              review_sla_hours=EXCLUDED.review_sla_hours,
+		              escalation_hours=EXCLUDED.escalation_hours
+	`, settings.TeamName, settings.ReviewerStrategy, settings.ReviewerCount, settings.MinApprovals,
+		nonNil(settings.FallbackTeams), settings.ReviewSLAHours, settings.EscalationHours)
+	return err
+}
+
+// GetCodeowners — файл владения команды; пустой Content, если он не загружен.
+func (r *teamRepo) GetCodeowners(ctx context.Context, teamName string) (domain.TeamCodeowners, error) {
+	co := domain.TeamCodeowners{TeamName: teamName}
+
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`SELECT content, updated_at FROM team_codeowners WHERE team_name=$1`,
+		teamName,
+	).Scan(&co.Content, &co.UpdatedAt)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		exists, err := r.exists(ctx, teamName)
+		if err != nil {
+			return domain.TeamCodeowners{}, err
+		}
+		if !exists {
+			return domain.TeamCodeowners{}, ErrTeamNotFound
+		}
+		return co, nil
+	}
+	if err != nil {
+		return domain.TeamCodeowners{}, err
+	}
+
+	return co, nil
+}
+
+func (r *teamRepo) SaveCodeowners(ctx context.Context, co domain.TeamCodeowners) error {
+	exists, err := r.exists(ctx, co.TeamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrTeamNotFound
+	}
+
+	_, err = conn(ctx, r.db).Exec(ctx, `
+		INSERT INTO team_codeowners (team_name, content, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_name)
+		DO UPDATE SET content=EXCLUDED.content,
+		              updated_at=EXCLUDED.updated_at
+	`, co.TeamName, co.Content, co.UpdatedAt)
+	return err
+}
+
+func (r *teamRepo) AdvanceRoundRobin(ctx context.Context, queue string, step int) (int, error) {
+	var prev int
+	err := conn(ctx, r.db).QueryRow(ctx, `
+		INSERT INTO round_robin_cursors (queue, next_index)
+		VALUES ($1, $2)
+		ON CONFLICT (queue)
+		DO UPDATE SET next_index=round_robin_cursors.next_index + EXCLUDED.next_index
+		RETURNING next_index - $2
+	`, queue, step).Scan(&prev)
+	return prev, err
+}
+
+// nonNil — NULL в TEXT[] NOT NULL не пройдёт, nil-слайс пишем как '{}'.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
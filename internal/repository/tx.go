@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Transactor выполняет fn в одной транзакции: все репозитории, вызванные
+// с переданным в fn контекстом, работают внутри неё. Ошибка из fn
+// откатывает всё, что было сделано.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+type pgTransactor struct {
+	db DB
+}
+
+func NewTransactor(db DB) Transactor {
+	return &pgTransactor{db: db}
+}
+
+func (t *pgTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Вложенный вызов присоединяется к уже открытой транзакции
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // после Commit это no-op
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// conn возвращает транзакцию из ctx, если она открыта, иначе db.
+func conn(ctx context.Context, db DB) DB {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db
+}
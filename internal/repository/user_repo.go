@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"pr-reviewer-service/internal/domain"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 )
@@ -14,6 +15,22 @@ type UserRepository interface {
 	Get(ctx context.Context, userID string) (*domain.User, error)
 	GetActiveUsersByTeam(ctx context.Context, team string) ([]domain.User, error)
 	DeactivateMany(ctx context.Context, ids []string) error
+
+	// GetAvailableUsersByTeam / GetAvailableUsers — активные пользователи,
+	// у которых на момент at нет отсутствия.
+	GetAvailableUsersByTeam(ctx context.Context, team string, at time.Time) ([]domain.User, error)
+	GetAvailableUsers(ctx context.Context, at time.Time) ([]domain.User, error)
+
+	AddAbsence(ctx context.Context, absence domain.Absence) (domain.Absence, error)
+	// UpsertAbsence добавляет отсутствие или обновляет уже импортированное
+	// с тем же (user_id, UID). UID должен быть непустым.
+	UpsertAbsence(ctx context.Context, absence domain.Absence) (domain.Absence, error)
+	ListAbsences(ctx context.Context, userID string) ([]domain.Absence, error)
+	DeleteAbsence(ctx context.Context, userID string, absenceID int64) error
+
+	SetExternalLogin(ctx context.Context, login domain.ExternalLogin) error
+	// ResolveLogin — user_id по логину во внешней системе (ErrUserNotFound, если не связан).
+	ResolveLogin(ctx context.Context, provider domain.Provider, login string) (string, error)
 }
 type userRepo struct {
 	db DB
@@ -24,7 +41,7 @@ func NewUserRepository(db DB) UserRepository {
 }
 
 func (r *userRepo) Create(ctx context.Context, user domain.User) error {
-	_, err := r.db.Exec(ctx,
+	_, err := conn(ctx, r.db).Exec(ctx,
 		`INSERT INTO users (user_id, username, team_name, is_active)
 		 VALUES ($1, $2, $3, $4)
 		 ON CONFLICT (user_id)
@@ -37,7 +54,7 @@ func (r *userRepo) Create(ctx context.Context, user domain.User) error {
 }
 
 func (r *userRepo) SetActive(ctx context.Context, userID string, active bool) error {
-	tag, err := r.db.Exec(ctx,
+	tag, err := conn(ctx, r.db).Exec(ctx,
 		`UPDATE users SET is_active=$2 WHERE user_id=$1`,
 		userID, active,
 	)
@@ -52,7 +69,7 @@ func (r *userRepo) SetActive(ctx context.Context, userID string, active bool) er
 
 func (r *userRepo) Get(ctx context.Context, userID string) (*domain.User, error) {
 	var u domain.User
-	err := r.db.QueryRow(ctx,
+	err := conn(ctx, r.db).QueryRow(ctx,
 		`SELECT user_id, username, team_name, is_active
 		   FROM users WHERE user_id=$1`,
 		userID,
@@ -65,7 +82,7 @@ func (r *userRepo) Get(ctx context.Context, userID string) (*domain.User, error)
 }
 
 func (r *userRepo) GetActiveUsersByTeam(ctx context.Context, team string) ([]domain.User, error) {
-	rows, err := r.db.Query(ctx,
+	rows, err := conn(ctx, r.db).Query(ctx,
 		`SELECT user_id, username, team_name, is_active
 		   FROM users
 		  WHERE team_name=$1 AND is_active=true`,
@@ -87,9 +104,137 @@ func (r *userRepo) GetActiveUsersByTeam(ctx context.Context, team string) ([]dom
 	return result, nil
 }
 func (r *userRepo) DeactivateMany(ctx context.Context, ids []string) error {
-	_, err := r.db.Exec(ctx, `
+	_, err := conn(ctx, r.db).Exec(ctx, `
 		UPDATE users SET is_active = false
 		WHERE user_id = ANY($1)
 	`, ids)
 	return err
 }
+
+func (r *userRepo) GetAvailableUsersByTeam(ctx context.Context, team string, at time.Time) ([]domain.User, error) {
+	return r.queryUsers(ctx,
+		`SELECT u.user_id, u.username, u.team_name, u.is_active
+		   FROM users u
+		  WHERE u.team_name=$1 AND u.is_active=true
+		    AND NOT EXISTS (
+		        SELECT 1 FROM user_absences a
+		         WHERE a.user_id = u.user_id
+		           AND a.starts_at <= $2 AND a.ends_at > $2)`,
+		team, at,
+	)
+}
+
+func (r *userRepo) GetAvailableUsers(ctx context.Context, at time.Time) ([]domain.User, error) {
+	return r.queryUsers(ctx,
+		`SELECT u.user_id, u.username, u.team_name, u.is_active
+		   FROM users u
+		  WHERE u.is_active=true
+		    AND NOT EXISTS (
+		        SELECT 1 FROM user_absences a
+		         WHERE a.user_id = u.user_id
+		           AND a.starts_at <= $1 AND a.ends_at > $1)`,
+		at,
+	)
+}
+
+func (r *userRepo) queryUsers(ctx context.Context, query string, args ...any) ([]domain.User, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.User
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			return nil, err
+		}
+		result = append(result, u)
+	}
+	return result, rows.Err()
+}
+
+func (r *userRepo) AddAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`INSERT INTO user_absences (user_id, starts_at, ends_at, reason)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING absence_id`,
+		a.UserID, a.StartsAt, a.EndsAt, a.Reason,
+	).Scan(&a.ID)
+	return a, err
+}
+
+func (r *userRepo) UpsertAbsence(ctx context.Context, a domain.Absence) (domain.Absence, error) {
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`INSERT INTO user_absences (user_id, starts_at, ends_at, reason, uid)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (user_id, uid)
+		 DO UPDATE SET starts_at=excluded.starts_at, ends_at=excluded.ends_at, reason=excluded.reason
+		 RETURNING absence_id`,
+		a.UserID, a.StartsAt, a.EndsAt, a.Reason, a.UID,
+	).Scan(&a.ID)
+	return a, err
+}
+
+func (r *userRepo) ListAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	rows, err := conn(ctx, r.db).Query(ctx,
+		`SELECT absence_id, user_id, starts_at, ends_at, reason, COALESCE(uid, '')
+		   FROM user_absences
+		  WHERE user_id=$1
+		  ORDER BY starts_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.Absence
+	for rows.Next() {
+		var a domain.Absence
+		if err := rows.Scan(&a.ID, &a.UserID, &a.StartsAt, &a.EndsAt, &a.Reason, &a.UID); err != nil {
+			return nil, err
+		}
+		result = append(result, a)
+	}
+	return result, rows.Err()
+}
+
+func (r *userRepo) DeleteAbsence(ctx context.Context, userID string, absenceID int64) error {
+	tag, err := conn(ctx, r.db).Exec(ctx,
+		`DELETE FROM user_absences WHERE absence_id=$1 AND user_id=$2`,
+		absenceID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrAbsenceNotFound
+	}
+	return nil
+}
+
+func (r *userRepo) SetExternalLogin(ctx context.Context, l domain.ExternalLogin) error {
+	_, err := conn(ctx, r.db).Exec(ctx,
+		`INSERT INTO external_logins (provider, login, user_id)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (provider, login)
+		 DO UPDATE SET user_id=EXCLUDED.user_id`,
+		l.Provider, l.Login, l.UserID,
+	)
+	return err
+}
+
+func (r *userRepo) ResolveLogin(ctx context.Context, provider domain.Provider, login string) (string, error) {
+	var userID string
+	err := conn(ctx, r.db).QueryRow(ctx,
+		`SELECT user_id FROM external_logins WHERE provider=$1 AND login=$2`,
+		provider, login,
+	).Scan(&userID)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrUserNotFound
+	}
+	return userID, err
+}
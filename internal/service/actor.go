@@ -0,0 +1,21 @@
+package service
+
+import "context"
+
+// SystemActor — инициатор изменений, для которых актор не задан.
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// WithActor помечает ctx инициатором изменений для журнала назначений
+// (например, "api", "github", "sla").
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
@@ -0,0 +1,21 @@
+package service
+
+import "pr-reviewer-service/internal/domain"
+
+// Metrics — доменные счётчики (реализация — internal/metrics).
+// Изменения считаются после фиксации транзакции, ErrNoCandidate — в момент,
+// когда подбор ревьювера не нашёл кандидатов, даже если вызывающий код
+// продолжает без них.
+type Metrics interface {
+	PRCreated()
+	PRMerged()
+	ReviewerReassigned(reason domain.AssignmentReason)
+	NoCandidate()
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) PRCreated()                                   {}
+func (nopMetrics) PRMerged()                                    {}
+func (nopMetrics) ReviewerReassigned(_ domain.AssignmentReason) {}
+func (nopMetrics) NoCandidate()                                 {}
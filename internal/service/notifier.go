@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// Notifier — получатель уведомлений (sink), в который outbox relay
+// доставляет записи. Notify возвращает управление после доставки:
+// ошибка оставляет запись в outbox для повтора.
+type Notifier interface {
+	Notify(ctx context.Context, n domain.Notification) error
+}
+
+// LogNotifier пишет уведомления в лог сервиса.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, n domain.Notification) error {
+	log.Printf("notification %s #%s: pr=%s user=%s replaced_by=%s team=%s reason=%q",
+		n.Kind, n.ID, n.PRID, n.UserID, n.ReplacedBy, n.TeamName, n.Reason)
+	return nil
+}
+
+// WriterNotifier пишет уведомления в w построчно в JSON (например, в stdout
+// для сборщика логов).
+type WriterNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+func (n *WriterNotifier) Notify(_ context.Context, msg domain.Notification) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err = n.w.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+const (
+	relayBatchSize  = 10
+	relayBaseDelay  = 5 * time.Second
+	relayMaxBackoff = time.Hour
+	// relayMaxAttempts — после стольких неудачных попыток (около полусуток
+	// с учётом пауз) запись снимается с доставки.
+	relayMaxAttempts = 20
+	// relayEventTimeout — предел доставки одной записи всем получателям,
+	// с повторами внутри sink.
+	relayEventTimeout = time.Minute
+	// relayLease — на сколько пакет уходит в аренду одному relay: хватает
+	// на доставку всего пакета даже при таймауте каждой записи.
+	relayLease = relayBatchSize * relayEventTimeout
+)
+
+// OutboxRelay доставляет записи outbox во все sinks. Запись отмечается
+// отправленной, только когда её приняли все получатели, иначе повторяется
+// целиком с экспоненциальной паузой — доставка «хотя бы один раз»,
+// дубликаты получатели отбрасывают по Notification.ID. Запись, которую
+// получатели отвергли окончательно (domain.ErrUndeliverable) или не
+// приняли за relayMaxAttempts попыток, снимается с доставки (MarkDead).
+type OutboxRelay struct {
+	repo  repository.OutboxRepository
+	tx    repository.Transactor
+	sinks []Notifier
+
+	// eventTimeout и lease — relayEventTimeout и relayLease; тесты их уменьшают.
+	eventTimeout time.Duration
+	lease        time.Duration
+}
+
+func NewOutboxRelay(repo repository.OutboxRepository, tx repository.Transactor, sinks ...Notifier) *OutboxRelay {
+	return &OutboxRelay{
+		repo: repo, tx: tx, sinks: sinks,
+		eventTimeout: relayEventTimeout,
+		lease:        relayLease,
+	}
+}
+
+// Run доставляет outbox каждые interval, пока ctx не отменён. Полный
+// пакет означает, что записей больше, — следующий берётся сразу.
+func (r *OutboxRelay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := r.RelayOnce(ctx, time.Now().UTC())
+			if err != nil {
+				log.Printf("outbox relay: %v", err)
+			}
+			if err != nil || n < relayBatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+	}
+}
+
+// RelayOnce обрабатывает один пакет готовых записей и возвращает, сколько
+// из них обработано. Пакет берётся в аренду короткой транзакцией, поэтому
+// несколько экземпляров сервиса не отправляют одну запись одновременно.
+// Доставка идёт без открытой транзакции — медленный получатель не держит
+// базу, — а итог каждой записи фиксируется своей короткой транзакцией.
+// Запись, на доставку которой аренды уже может не хватить, не трогается:
+// она вернётся в очередь после конца аренды, и её не отправят двое.
+func (r *OutboxRelay) RelayOnce(ctx context.Context, now time.Time) (int, error) {
+	leased := time.Now()
+	events, err := inTx(ctx, r.tx, func(ctx context.Context) ([]domain.OutboxEvent, error) {
+		return r.repo.LeasePending(ctx, now, now.Add(r.lease), relayBatchSize)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for i, e := range events {
+		if time.Since(leased)+r.eventTimeout > r.lease {
+			return i, nil
+		}
+
+		deliverErr := r.deliver(ctx, e.Notification)
+		err := r.tx.WithinTx(ctx, func(ctx context.Context) error {
+			switch {
+			case deliverErr == nil:
+				return r.repo.MarkSent(ctx, e.ID)
+			case errors.Is(deliverErr, domain.ErrUndeliverable) || e.Attempts+1 >= relayMaxAttempts:
+				log.Printf("outbox relay: event %s dropped after %d attempts: %v", e.ID, e.Attempts+1, deliverErr)
+				return r.repo.MarkDead(ctx, e.ID, deliverErr.Error())
+			default:
+				next := now.Add(relayBackoff(e.Attempts))
+				return r.repo.MarkFailed(ctx, e.ID, deliverErr.Error(), next)
+			}
+		})
+		if err != nil {
+			// запись вернётся в очередь, когда истечёт аренда
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+// deliver отправляет n во все sinks за eventTimeout. Ошибка оборачивает
+// domain.ErrUndeliverable, только если окончательно отказали все
+// не принявшие получатели.
+func (r *OutboxRelay) deliver(ctx context.Context, n domain.Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, r.eventTimeout)
+	defer cancel()
+
+	var failed []string
+	undeliverable := true
+	for _, sink := range r.sinks {
+		if err := sink.Notify(ctx, n); err != nil {
+			failed = append(failed, err.Error())
+			undeliverable = undeliverable && errors.Is(err, domain.ErrUndeliverable)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	err := fmt.Errorf("notify %s: %s", n.Kind, strings.Join(failed, "; "))
+	if undeliverable {
+		return fmt.Errorf("%w: %w", domain.ErrUndeliverable, err)
+	}
+	return err
+}
+
+// relayBackoff — пауза перед следующей попыткой после attempts неудачных.
+func relayBackoff(attempts int) time.Duration {
+	d := relayBaseDelay
+	for i := 0; i < attempts && d < relayMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, relayMaxBackoff)
+}
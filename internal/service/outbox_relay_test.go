@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+)
+
+type notifierFunc func(ctx context.Context, n domain.Notification) error
+
+func (f notifierFunc) Notify(ctx context.Context, n domain.Notification) error { return f(ctx, n) }
+
+func newOutbox(t *testing.T, n int) (*memory.Store, repository.OutboxRepository) {
+	t.Helper()
+	store := memory.NewStore()
+	repo := memory.NewOutboxRepository(store)
+	for range n {
+		err := repo.Add(context.Background(), domain.Notification{Kind: domain.NotifyReviewerAssigned, PRID: "pr-1"})
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	return store, repo
+}
+
+func TestRelayDeliversOutsideTx(t *testing.T) {
+	store, repo := newOutbox(t, 1)
+
+	delivering := make(chan struct{})
+	release := make(chan struct{})
+	sink := notifierFunc(func(context.Context, domain.Notification) error {
+		close(delivering)
+		<-release
+		return nil
+	})
+	relay := NewOutboxRelay(repo, store, sink)
+
+	now := time.Now().UTC().Add(time.Second)
+	done := make(chan error, 1)
+	go func() {
+		_, err := relay.RelayOnce(context.Background(), now)
+		done <- err
+	}()
+	<-delivering
+
+	// пока получатель отвечает, база свободна: memory.Store держит мьютекс
+	// на всё время транзакции, так что при открытой транзакции здесь был бы
+	// deadlock
+	txDone := make(chan error, 1)
+	go func() {
+		txDone <- store.WithinTx(context.Background(), func(ctx context.Context) error {
+			return repo.Add(ctx, domain.Notification{Kind: domain.NotifyReviewerAssigned})
+		})
+	}()
+	select {
+	case err := <-txDone:
+		if err != nil {
+			t.Fatalf("concurrent tx: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("concurrent tx blocked while relay was delivering")
+	}
+
+	// арендованную запись другой relay не берёт
+	other := NewOutboxRelay(repo, store, notifierFunc(func(_ context.Context, n domain.Notification) error {
+		if n.PRID == "pr-1" {
+			t.Errorf("leased event %s delivered twice", n.ID)
+		}
+		return nil
+	}))
+	if _, err := other.RelayOnce(context.Background(), now); err != nil {
+		t.Fatalf("other relay: %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("RelayOnce: %v", err)
+	}
+
+	// отправленная запись больше не выдаётся, даже после конца аренды
+	left, err := repo.LeasePending(context.Background(), now.Add(2*relayLease), now.Add(3*relayLease), relayBatchSize)
+	if err != nil {
+		t.Fatalf("LeasePending: %v", err)
+	}
+	if len(left) != 0 {
+		t.Errorf("pending after delivery: %+v", left)
+	}
+}
+
+func TestRelayRetriesFailedDelivery(t *testing.T) {
+	store, repo := newOutbox(t, 2)
+
+	fail := true
+	var delivered int
+	relay := NewOutboxRelay(repo, store, notifierFunc(func(context.Context, domain.Notification) error {
+		if fail {
+			return errors.New("receiver is down")
+		}
+		delivered++
+		return nil
+	}))
+
+	ctx := context.Background()
+	now := time.Now().UTC().Add(time.Second)
+	if n, err := relay.RelayOnce(ctx, now); err != nil || n != 2 {
+		t.Fatalf("RelayOnce = %d, %v; want 2, nil", n, err)
+	}
+
+	// до конца паузы повторов нет
+	if n, err := relay.RelayOnce(ctx, now.Add(relayBackoff(0)-time.Millisecond)); err != nil || n != 0 {
+		t.Fatalf("RelayOnce before backoff = %d, %v; want 0, nil", n, err)
+	}
+
+	fail = false
+	if n, err := relay.RelayOnce(ctx, now.Add(relayBackoff(0))); err != nil || n != 2 {
+		t.Fatalf("RelayOnce after backoff = %d, %v; want 2, nil", n, err)
+	}
+	if delivered != 2 {
+		t.Errorf("delivered = %d, want 2", delivered)
+	}
+	if n, err := relay.RelayOnce(ctx, now.Add(time.Hour)); err != nil || n != 0 {
+		t.Errorf("RelayOnce after success = %d, %v; want 0, nil", n, err)
+	}
+}
+
+func TestRelayBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, relayBaseDelay},
+		{1, 2 * relayBaseDelay},
+		{3, 8 * relayBaseDelay},
+		{100, relayMaxBackoff},
+	}
+	for _, tt := range tests {
+		if got := relayBackoff(tt.attempts); got != tt.want {
+			t.Errorf("relayBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// Окончательный отказ получателя не повторяется: запись снимается
+// с доставки сразу, а остальные записи пакета доставляются как обычно.
+func TestRelayDropsUndeliverableEvents(t *testing.T) {
+	store, repo := newOutbox(t, 2)
+
+	calls := map[string]int{}
+	relay := NewOutboxRelay(repo, store, notifierFunc(func(_ context.Context, n domain.Notification) error {
+		calls[n.ID]++
+		if n.ID == "1" {
+			return fmt.Errorf("%w: status 410", domain.ErrUndeliverable)
+		}
+		return nil
+	}))
+
+	ctx := context.Background()
+	now := time.Now().UTC().Add(time.Second)
+	if n, err := relay.RelayOnce(ctx, now); err != nil || n != 2 {
+		t.Fatalf("RelayOnce = %d, %v; want 2, nil", n, err)
+	}
+	for _, at := range []time.Time{now.Add(relayBackoff(0)), now.Add(relayLease), now.Add(48 * time.Hour)} {
+		if n, err := relay.RelayOnce(ctx, at); err != nil || n != 0 {
+			t.Errorf("RelayOnce at +%v = %d, %v; want 0, nil", at.Sub(now), n, err)
+		}
+	}
+	if calls["1"] != 1 || calls["2"] != 1 {
+		t.Errorf("deliveries = %v, want one per event", calls)
+	}
+}
+
+// Если отказал окончательно только один из получателей, запись повторяется.
+func TestRelayRetriesWhenAnySinkMayAccept(t *testing.T) {
+	store, repo := newOutbox(t, 1)
+	relay := NewOutboxRelay(repo, store,
+		notifierFunc(func(context.Context, domain.Notification) error {
+			return fmt.Errorf("%w: status 404", domain.ErrUndeliverable)
+		}),
+		notifierFunc(func(context.Context, domain.Notification) error {
+			return errors.New("receiver is down")
+		}),
+	)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Add(time.Second)
+	if _, err := relay.RelayOnce(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := relay.RelayOnce(ctx, now.Add(relayBackoff(0))); err != nil || n != 1 {
+		t.Errorf("RelayOnce after backoff = %d, %v; want the event retried", n, err)
+	}
+}
+
+func TestRelayGivesUpAfterMaxAttempts(t *testing.T) {
+	store, repo := newOutbox(t, 1)
+
+	var calls int
+	relay := NewOutboxRelay(repo, store, notifierFunc(func(context.Context, domain.Notification) error {
+		calls++
+		return errors.New("receiver is down")
+	}))
+
+	ctx := context.Background()
+	now := time.Now().UTC().Add(time.Second)
+	for attempt := range relayMaxAttempts {
+		if n, err := relay.RelayOnce(ctx, now); err != nil || n != 1 {
+			t.Fatalf("attempt %d: RelayOnce = %d, %v; want 1, nil", attempt+1, n, err)
+		}
+		now = now.Add(relayBackoff(attempt))
+	}
+	if n, err := relay.RelayOnce(ctx, now.Add(48*time.Hour)); err != nil || n != 0 {
+		t.Errorf("RelayOnce after the last attempt = %d, %v; want 0, nil", n, err)
+	}
+	if calls != relayMaxAttempts {
+		t.Errorf("deliveries = %d, want %d", calls, relayMaxAttempts)
+	}
+}
+
+// Каждая доставка ограничена eventTimeout, а записи, на которые аренды
+// может не хватить, relay оставляет: после конца аренды их возьмёт
+// следующий запуск, и никто не отправит запись дважды.
+func TestRelayStaysWithinLease(t *testing.T) {
+	store, repo := newOutbox(t, relayBatchSize)
+
+	var calls []string
+	// зависший получатель: ждёт, пока relay не оборвёт доставку
+	relay := NewOutboxRelay(repo, store, notifierFunc(func(ctx context.Context, n domain.Notification) error {
+		calls = append(calls, n.ID)
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+	relay.eventTimeout = 20 * time.Millisecond
+	relay.lease = 100 * time.Millisecond
+
+	ctx := context.Background()
+	now := time.Now().UTC().Add(time.Second)
+	n, err := relay.RelayOnce(ctx, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 || n >= relayBatchSize || len(calls) != n {
+		t.Fatalf("RelayOnce = %d, deliveries %v; want part of the batch before the lease runs out", n, calls)
+	}
+
+	// до конца аренды необработанный остаток никому не выдаётся
+	if n, err := relay.RelayOnce(ctx, now.Add(relay.lease-time.Millisecond)); err != nil || n != 0 {
+		t.Errorf("RelayOnce during the lease = %d, %v; want 0, nil", n, err)
+	}
+
+	// после аренды возвращается ровно остаток и без лишней попытки
+	// в счётчике, а оборванные по таймауту ждут своей паузы
+	left, err := repo.LeasePending(ctx, now.Add(relay.lease), now.Add(2*relay.lease), relayBatchSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != relayBatchSize-n {
+		t.Fatalf("%d events back in the queue, want %d", len(left), relayBatchSize-n)
+	}
+	for _, e := range left {
+		if slices.Contains(calls, e.ID) || e.Attempts != 0 {
+			t.Errorf("event %s (attempts %d) was already delivered", e.ID, e.Attempts)
+		}
+	}
+}
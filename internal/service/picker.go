@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// ReviewerPicker выбирает до limit ревьюверов из уже отфильтрованных кандидатов.
+type ReviewerPicker interface {
+	Pick(ctx context.Context, team string, candidates []string, limit int) ([]string, error)
+}
+
+// ReviewLoader отдаёт нагрузку кандидатов: user_id -> число назначений
+// на открытые PR. Реализуется repository.PRRepository.
+type ReviewLoader interface {
+	OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+}
+
+// RoundRobinCursors хранит позиции round-robin по командам.
+// Реализуется repository.TeamRepository.
+type RoundRobinCursors interface {
+	AdvanceRoundRobin(ctx context.Context, queue string, step int) (int, error)
+}
+
+// DefaultPickers — встроенные стратегии, доступные командам через настройки.
+func DefaultPickers(loader ReviewLoader, cursors RoundRobinCursors) map[domain.ReviewerStrategy]ReviewerPicker {
+	rnd := newSafeRand()
+	return map[domain.ReviewerStrategy]ReviewerPicker{
+		domain.StrategyRandom:      &randomPicker{rnd: rnd},
+		domain.StrategyRoundRobin:  &roundRobinPicker{cursors: cursors},
+		domain.StrategyLeastLoaded: &leastLoadedPicker{loader: loader, rnd: rnd},
+		domain.StrategyWeighted:    &weightedPicker{loader: loader, rnd: rnd},
+	}
+}
+
+// ----------------- RANDOM -----------------
+
+type randomPicker struct {
+	rnd *safeRand
+}
+
+func (p *randomPicker) Pick(_ context.Context, _ string, candidates []string, limit int) ([]string, error) {
+	if len(candidates) <= limit {
+		return candidates, nil
+	}
+
+	res := make([]string, 0, limit)
+	for i := 0; i < limit; i++ {
+		j := i + p.rnd.Intn(len(candidates)-i)
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+		res = append(res, candidates[i])
+	}
+	return res, nil
+}
+
+// ----------------- ROUND-ROBIN -----------------
+
+// roundRobinPicker идёт по отсортированному списку кандидатов,
+// запоминая позицию отдельно для каждой команды. Позиция хранится
+// в базе: очередь переживает рестарт, общая для реплик и сдвигается
+// в транзакции назначения — откат не сбивает очередь.
+type roundRobinPicker struct {
+	cursors RoundRobinCursors
+}
+
+func (p *roundRobinPicker) Pick(ctx context.Context, team string, candidates []string, limit int) ([]string, error) {
+	if len(candidates) <= limit {
+		return candidates, nil
+	}
+
+	sort.Strings(candidates)
+
+	start, err := p.cursors.AdvanceRoundRobin(ctx, team, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, limit)
+	for i := 0; i < limit; i++ {
+		res = append(res, candidates[(start+i)%len(candidates)])
+	}
+	return res, nil
+}
+
+// ----------------- LEAST-LOADED -----------------
+
+// leastLoadedPicker берёт наименее загруженных кандидатов,
+// при равной нагрузке — в случайном порядке.
+type leastLoadedPicker struct {
+	loader ReviewLoader
+	rnd    *safeRand
+}
+
+func (p *leastLoadedPicker) Pick(ctx context.Context, _ string, candidates []string, limit int) ([]string, error) {
+	if len(candidates) <= limit {
+		return candidates, nil
+	}
+
+	load, err := p.loader.OpenReviewCounts(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(candidates) - 1; i > 0; i-- {
+		j := p.rnd.Intn(i + 1)
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return load[candidates[i]] < load[candidates[j]]
+	})
+	return candidates[:limit], nil
+}
+
+// ----------------- WEIGHTED -----------------
+
+// weightedPicker — случайный выбор без повторов, где вес кандидата
+// обратно пропорционален его нагрузке: 1 / (1 + load).
+type weightedPicker struct {
+	loader ReviewLoader
+	rnd    *safeRand
+}
+
+func (p *weightedPicker) Pick(ctx context.Context, _ string, candidates []string, limit int) ([]string, error) {
+	if len(candidates) <= limit {
+		return candidates, nil
+	}
+
+	load, err := p.loader.OpenReviewCounts(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make([]float64, len(candidates))
+	for i, id := range candidates {
+		weights[i] = 1 / float64(1+load[id])
+	}
+
+	res := make([]string, 0, limit)
+	for len(res) < limit {
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+
+		x := p.rnd.Float64() * total
+		idx := len(weights) - 1
+		for i, w := range weights {
+			if x < w {
+				idx = i
+				break
+			}
+			x -= w
+		}
+
+		res = append(res, candidates[idx])
+		candidates = append(candidates[:idx], candidates[idx+1:]...)
+		weights = append(weights[:idx], weights[idx+1:]...)
+	}
+	return res, nil
+}
+
+// ----------------- RAND -----------------
+
+// safeRand — *rand.Rand не потокобезопасен, а PRService обслуживает
+// конкурентные запросы.
+type safeRand struct {
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func newSafeRand() *safeRand {
+	return &safeRand{rnd: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (r *safeRand) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rnd.Intn(n)
+}
+
+func (r *safeRand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rnd.Float64()
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
-	"math/rand"
+	"fmt"
+	"strings"
 	"time"
 
+	"pr-reviewer-service/internal/codeowners"
 	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/repository"
 )
@@ -13,20 +15,77 @@ import (
 type PRService struct {
 	prRepo   repository.PRRepository
 	userRepo repository.UserRepository
-	rnd      *rand.Rand
+	teamRepo repository.TeamRepository
+	tx       repository.Transactor
+	pickers  map[domain.ReviewerStrategy]ReviewerPicker
+	outbox   repository.OutboxRepository
+	events   repository.AssignmentEventRepository
+	// defaultReviewers — число ревьюверов у команды без сохранённых настроек.
+	defaultReviewers int
+	metrics          Metrics
 }
 
-func NewPRService(prRepo repository.PRRepository, userRepo repository.UserRepository) *PRService {
+func NewPRService(
+	prRepo repository.PRRepository,
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	tx repository.Transactor,
+	outbox repository.OutboxRepository,
+	events repository.AssignmentEventRepository,
+	defaultReviewers int,
+	metrics Metrics,
+) *PRService {
+	if metrics == nil {
+		metrics = nopMetrics{}
+	}
 	return &PRService{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		rnd:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		prRepo:           prRepo,
+		userRepo:         userRepo,
+		teamRepo:         teamRepo,
+		tx:               tx,
+		pickers:          DefaultPickers(prRepo, teamRepo),
+		outbox:           outbox,
+		events:           events,
+		defaultReviewers: defaultReviewers,
+		metrics:          metrics,
 	}
 }
 
+// inTx выполняет fn в транзакции и возвращает её результат.
+func inTx[T any](ctx context.Context, tx repository.Transactor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var res T
+	err := tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = fn(ctx)
+		return err
+	})
+	return res, err
+}
+
+// NewPR — параметры создания PR.
+type NewPR struct {
+	ID       string
+	Name     string
+	AuthorID string
+	// Draft — черновик: ревьюверы назначаются при переводе в OPEN.
+	Draft bool
+	// Files — изменённые пути; по ним из CODEOWNERS команды подбираются владельцы.
+	Files []string
+}
+
 // ----------------- CREATE PR (+ автоназначение ревьюверов) -----------------
-func (s *PRService) Create(ctx context.Context, id, name, authorID string) (domain.PullRequest, error) {
-	author, err := s.userRepo.Get(ctx, authorID)
+func (s *PRService) Create(ctx context.Context, in NewPR) (domain.PullRequest, error) {
+	pr, err := inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.create(ctx, in)
+	})
+	if err == nil {
+		s.metrics.PRCreated()
+	}
+	return pr, err
+}
+
+func (s *PRService) create(ctx context.Context, in NewPR) (domain.PullRequest, error) {
+	author, err := s.userRepo.Get(ctx, in.AuthorID)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
 			return domain.PullRequest{}, domain.ErrUserNotFound
@@ -38,12 +97,16 @@ func (s *PRService) Create(ctx context.Context, id, name, authorID string) (doma
 	}
 
 	pr := domain.PullRequest{
-		ID:        id,
-		Name:      name,
-		AuthorID:  authorID,
+		ID:        in.ID,
+		Name:      in.Name,
+		AuthorID:  in.AuthorID,
 		Status:    domain.PRStatusOpen,
+		Files:     normalizePaths(in.Files),
 		CreatedAt: time.Now().UTC(),
 	}
+	if in.Draft {
+		pr.Status = domain.PRStatusDraft
+	}
 
 	if err := s.prRepo.Create(ctx, pr); err != nil {
 		if errors.Is(err, domain.ErrPRExists) {
@@ -52,79 +115,522 @@ func (s *PRService) Create(ctx context.Context, id, name, authorID string) (doma
 		return domain.PullRequest{}, err
 	}
 
-	reviewers, err := s.pickReviewers(ctx, author.TeamName, authorID, 2)
-	if err != nil && !errors.Is(err, domain.ErrNoCandidate) {
+	if pr.Status == domain.PRStatusDraft {
+		return pr, nil
+	}
+
+	return s.assignReviewers(ctx, pr, author)
+}
+
+// assignReviewers назначает ревьюверов PR: сначала по одному владельцу на
+// каждый изменённый путь из CODEOWNERS команды автора, затем добирает
+// до reviewer_count из команды. Владельцы обязательны, поэтому их может
+// оказаться больше reviewer_count. Если кандидатов нет, PR остаётся без ревьюверов.
+func (s *PRService) assignReviewers(ctx context.Context, pr domain.PullRequest, author *domain.User) (domain.PullRequest, error) {
+	settings, err := s.teamSettings(ctx, author.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
 
+	reviewers, err := s.pickOwners(ctx, settings, pr.Files, author.ID)
+	if err != nil {
 		return domain.PullRequest{}, err
 	}
+	owners := len(reviewers)
+
+	if need := settings.ReviewerCount - len(reviewers); need > 0 {
+		exclude := []string{author.ID}
+		for _, rv := range reviewers {
+			exclude = append(exclude, rv.ReviewerID)
+		}
 
-	for _, rID := range reviewers {
-		if err := s.prRepo.AddReviewer(ctx, pr.ID, rID); err != nil {
+		rest, err := s.pickReviewers(ctx, settings, need, exclude...)
+		if err != nil && !errors.Is(err, domain.ErrNoCandidate) {
 			return domain.PullRequest{}, err
 		}
+		reviewers = append(reviewers, rest...)
 	}
 
-	pr.Reviewers = reviewers
+	pr.Reviewers = nil
+	for i, rv := range reviewers {
+		if err := s.prRepo.AddReviewer(ctx, pr.ID, rv); err != nil {
+			return domain.PullRequest{}, err
+		}
+		reason := domain.ReasonAuto
+		if i < owners {
+			reason = domain.ReasonCodeowner
+		}
+		if err := s.record(ctx, pr.ID, rv, domain.ActionAssigned, reason, ""); err != nil {
+			return domain.PullRequest{}, err
+		}
+		pr.Reviewers = append(pr.Reviewers, rv.ReviewerID)
+		if err := s.outbox.Add(ctx, domain.Notification{
+			Kind: domain.NotifyReviewerAssigned, PRID: pr.ID, UserID: rv.ReviewerID,
+		}); err != nil {
+			return domain.PullRequest{}, err
+		}
+	}
+	pr.Reviews = reviewers
 	return pr, nil
 }
 
-func (s *PRService) pickReviewers(ctx context.Context, teamName, excludeUserID string, limit int) ([]string, error) {
-	users, err := s.userRepo.GetActiveUsersByTeam(ctx, teamName)
+// teamSettings — настройки команды (стратегия, число ревьюверов).
+func (s *PRService) teamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	return loadSettings(ctx, s.teamRepo, teamName, s.defaultReviewers)
+}
+
+// pickReviewers выбирает до limit доступных участников команды, кроме exclude.
+// Если своих кандидатов не хватает, недостающие добираются по цепочке
+// settings.FallbackTeams; такие ревьюверы помечаются FallbackTeam.
+func (s *PRService) pickReviewers(ctx context.Context, settings domain.TeamSettings, limit int, exclude ...string) ([]domain.Review, error) {
+	skip := make(map[string]bool, len(exclude))
+	for _, id := range exclude {
+		skip[id] = true
+	}
+
+	picker, ok := s.pickers[settings.ReviewerStrategy]
+	if !ok {
+		picker = s.pickers[domain.StrategyRandom]
+	}
+
+	var picked []domain.Review
+	sources := append([]string{settings.TeamName}, settings.FallbackTeams...)
+	for _, source := range sources {
+		if len(picked) >= limit {
+			break
+		}
+
+		users, err := s.availableUsers(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+
+		var candidates []string
+		teamOf := make(map[string]string, len(users))
+		for _, u := range users {
+			if skip[u.ID] {
+				continue
+			}
+			candidates = append(candidates, u.ID)
+			teamOf[u.ID] = u.TeamName
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		ids, err := picker.Pick(ctx, source, candidates, limit-len(picked))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			skip[id] = true
+			rv := domain.Review{ReviewerID: id}
+			if teamOf[id] != settings.TeamName {
+				rv.FallbackTeam = teamOf[id]
+			}
+			picked = append(picked, rv)
+		}
+	}
+
+	if len(picked) == 0 {
+		s.metrics.NoCandidate()
+		return nil, domain.ErrNoCandidate
+	}
+	return picked, nil
+}
+
+// pickOwners выбирает стратегией команды по одному доступному владельцу на
+// каждый путь из files, у которого в CODEOWNERS команды есть владельцы.
+// Путь уже покрыт, если один из его владельцев выбран для другого пути.
+// Владельцы, которых нет в сервисе или которые недоступны, пропускаются.
+func (s *PRService) pickOwners(ctx context.Context, settings domain.TeamSettings, files []string, exclude ...string) ([]domain.Review, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	co, err := s.teamRepo.GetCodeowners(ctx, settings.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if co.Content == "" {
+		return nil, nil
+	}
+	rules, err := codeowners.Parse(strings.NewReader(co.Content))
 	if err != nil {
 		return nil, err
 	}
 
-	var candidates []string
+	users, err := s.availableUsers(ctx, domain.AnyTeam)
+	if err != nil {
+		return nil, err
+	}
+	teamOf := make(map[string]string, len(users))
+	members := make(map[string][]string)
 	for _, u := range users {
-		if u.ID == excludeUserID {
+		teamOf[u.ID] = u.TeamName
+		members[u.TeamName] = append(members[u.TeamName], u.ID)
+	}
+
+	skip := make(map[string]bool, len(exclude))
+	for _, id := range exclude {
+		skip[id] = true
+	}
+
+	picker, ok := s.pickers[settings.ReviewerStrategy]
+	if !ok {
+		picker = s.pickers[domain.StrategyRandom]
+	}
+
+	var picked []domain.Review
+	chosen := make(map[string]bool)
+	for _, path := range files {
+		owners, _ := rules.Match(path)
+
+		var candidates []string
+		covered := false
+		seen := make(map[string]bool)
+		for _, o := range owners {
+			ids := members[o.Team]
+			if o.User != "" {
+				ids = []string{o.User}
+			}
+			for _, id := range ids {
+				if chosen[id] {
+					covered = true
+				}
+				if _, ok := teamOf[id]; !ok || skip[id] || seen[id] {
+					continue
+				}
+				seen[id] = true
+				candidates = append(candidates, id)
+			}
+		}
+		if covered || len(candidates) == 0 {
 			continue
 		}
-		candidates = append(candidates, u.ID)
-	}
 
-	if len(candidates) == 0 {
-		return nil, domain.ErrNoCandidate
+		// у владельцев своя очередь round-robin: подбор по CODEOWNERS
+		// не должен сдвигать очередь команды
+		ids, err := picker.Pick(ctx, ownersQueue(settings.TeamName), candidates, 1)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			chosen[id] = true
+			rv := domain.Review{ReviewerID: id}
+			if teamOf[id] != settings.TeamName {
+				rv.FallbackTeam = teamOf[id]
+			}
+			picked = append(picked, rv)
+		}
 	}
-	if len(candidates) <= limit {
-		return candidates, nil
+	return picked, nil
+}
+
+// ownersQueue — имя очереди round-robin для владельцев из CODEOWNERS команды.
+func ownersQueue(team string) string {
+	return "codeowners:" + team
+}
+
+// normalizePaths убирает пустые пути, ведущий "/" и дубликаты.
+func normalizePaths(paths []string) []string {
+	var res []string
+	seen := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		p = strings.TrimPrefix(strings.TrimSpace(p), "/")
+		if p == "" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		res = append(res, p)
 	}
+	return res
+}
 
-	res := make([]string, 0, limit)
-	for i := 0; i < limit; i++ {
-		j := i + s.rnd.Intn(len(candidates)-i)
-		candidates[i], candidates[j] = candidates[j], candidates[i]
-		res = append(res, candidates[i])
+// availableUsers — активные и не отсутствующие сейчас участники команды,
+// для AnyTeam — все такие пользователи.
+func (s *PRService) availableUsers(ctx context.Context, team string) ([]domain.User, error) {
+	now := time.Now().UTC()
+	if team == domain.AnyTeam {
+		return s.userRepo.GetAvailableUsers(ctx, now)
 	}
-	return res, nil
+	return s.userRepo.GetAvailableUsersByTeam(ctx, team, now)
 }
 
 // ----------------- MERGE (идемпотентный) -----------------
 
 func (s *PRService) Merge(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return s.mergeInTx(ctx, prID, false)
+}
+
+// MarkMergedExternally фиксирует merge, уже выполненный во внешней системе
+// (вебхуки GitHub и GitLab). Ни min_approvals, ни переходы статусов не
+// проверяются: PR там уже влит, и отказ навсегда оставил бы его у нас
+// открытым. Повторный вызов ничего не меняет.
+func (s *PRService) MarkMergedExternally(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return s.mergeInTx(ctx, prID, true)
+}
+
+func (s *PRService) mergeInTx(ctx context.Context, prID string, external bool) (domain.PullRequest, error) {
+	merged := false
+	pr, err := inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		var (
+			pr  domain.PullRequest
+			err error
+		)
+		pr, merged, err = s.merge(ctx, prID, external)
+		return pr, err
+	})
+	if err == nil && merged {
+		s.metrics.PRMerged()
+	}
+	return pr, err
+}
+
+// merge возвращает merged=false, если PR уже был в MERGED.
+// external — merge уже выполнен снаружи, проверки пропускаются.
+func (s *PRService) merge(ctx context.Context, prID string, external bool) (domain.PullRequest, bool, error) {
 	pr, err := s.prRepo.Get(ctx, prID)
 	if err != nil {
 		if errors.Is(err, domain.ErrPRNotFound) {
-			return domain.PullRequest{}, domain.ErrPRNotFound
+			return domain.PullRequest{}, false, domain.ErrPRNotFound
 		}
-		return domain.PullRequest{}, err
+		return domain.PullRequest{}, false, err
 	}
 
 	if pr.Status == domain.PRStatusMerged {
-		return pr, nil
+		return pr, false, nil
+	}
+	if !external {
+		if !pr.Status.CanTransitionTo(domain.PRStatusMerged) {
+			return domain.PullRequest{}, false, transitionError(pr.Status, domain.PRStatusMerged)
+		}
+		if err := s.checkApprovals(ctx, pr); err != nil {
+			return domain.PullRequest{}, false, err
+		}
 	}
 
 	if err := s.prRepo.Merge(ctx, prID); err != nil {
+		if errors.Is(err, domain.ErrPRNotFound) {
+			return domain.PullRequest{}, false, domain.ErrPRNotFound
+		}
+		return domain.PullRequest{}, false, err
+	}
+	if err := s.outbox.Add(ctx, domain.Notification{
+		Kind: domain.NotifyPRMerged, PRID: prID, UserID: pr.AuthorID,
+	}); err != nil {
+		return domain.PullRequest{}, false, err
+	}
+
+	pr, err = s.prRepo.Get(ctx, prID)
+	return pr, err == nil, err
+}
+
+// checkApprovals — правило min_approvals команды автора (0 — без ограничения),
+// но не больше числа назначенных на PR ревьюверов. PR без ревьюверов
+// при min_approvals > 0 не вливается: апрувить его некому.
+func (s *PRService) checkApprovals(ctx context.Context, pr domain.PullRequest) error {
+	author, err := s.userRepo.Get(ctx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	settings, err := s.teamSettings(ctx, author.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if settings.MinApprovals > 0 && len(pr.Reviewers) == 0 {
+		return fmt.Errorf("%w: no reviewers assigned, need %d",
+			domain.ErrNotEnoughApprovals, settings.MinApprovals)
+	}
+
+	// ревьюверов могло найтись меньше min_approvals (маленькая команда,
+	// все в отпуске) — тогда хватает апрувов от всех назначенных
+	need := min(settings.MinApprovals, len(pr.Reviewers))
+	if pr.Approvals() < need {
+		return fmt.Errorf("%w: have %d, need %d",
+			domain.ErrNotEnoughApprovals, pr.Approvals(), need)
+	}
+	return nil
+}
+
+// ----------------- LIFECYCLE: CLOSE / REOPEN / READY / DRAFT -----------------
+
+// Close закрывает PR без merge и снимает с него ревьюверов (идемпотентно).
+func (s *PRService) Close(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.close(ctx, prID)
+	})
+}
+
+func (s *PRService) close(ctx context.Context, prID string) (domain.PullRequest, error) {
+	pr, err := s.getForTransition(ctx, prID, domain.PRStatusClosed)
+	if err != nil || pr.Status == domain.PRStatusClosed {
+		return pr, err
+	}
+
+	if err := s.removeReviewers(ctx, pr, domain.ReasonPRClosed); err != nil {
+		return domain.PullRequest{}, err
+	}
+	if err := s.prRepo.SetStatus(ctx, prID, domain.PRStatusClosed); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return s.prRepo.Get(ctx, prID)
+}
+
+// Reopen возвращает закрытый PR в OPEN и заново назначает ревьюверов.
+func (s *PRService) Reopen(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.open(ctx, prID, domain.PRStatusClosed)
+	})
+}
+
+// MarkReady переводит черновик в OPEN и назначает ревьюверов.
+func (s *PRService) MarkReady(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.open(ctx, prID, domain.PRStatusDraft)
+	})
+}
+
+// MarkDraft возвращает открытый PR в черновик и снимает ревьюверов
+// (идемпотентно): они будут назначены заново при MarkReady.
+func (s *PRService) MarkDraft(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.markDraft(ctx, prID)
+	})
+}
+
+func (s *PRService) markDraft(ctx context.Context, prID string) (domain.PullRequest, error) {
+	pr, err := s.getForTransition(ctx, prID, domain.PRStatusDraft)
+	if err != nil || pr.Status == domain.PRStatusDraft {
+		return pr, err
+	}
+
+	if err := s.removeReviewers(ctx, pr, domain.ReasonPRDraft); err != nil {
+		return domain.PullRequest{}, err
+	}
+	if err := s.prRepo.SetStatus(ctx, prID, domain.PRStatusDraft); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return s.prRepo.Get(ctx, prID)
+}
+
+// open — переход from -> OPEN с назначением ревьюверов (OPEN -> OPEN — no-op).
+func (s *PRService) open(ctx context.Context, prID string, from domain.PRStatus) (domain.PullRequest, error) {
+	pr, err := s.getForTransition(ctx, prID, domain.PRStatusOpen)
+	if err != nil || pr.Status == domain.PRStatusOpen {
+		return pr, err
+	}
+	if pr.Status != from {
+		return domain.PullRequest{}, fmt.Errorf("%w: expected %s, got %s",
+			domain.ErrInvalidTransition, from, pr.Status)
+	}
+
+	author, err := s.userRepo.Get(ctx, pr.AuthorID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if err := s.prRepo.SetStatus(ctx, prID, domain.PRStatusOpen); err != nil {
+		return domain.PullRequest{}, err
+	}
+	if _, err := s.assignReviewers(ctx, pr, author); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return s.prRepo.Get(ctx, prID)
+}
+
+// getForTransition загружает PR и проверяет, что в next из его статуса перейти можно.
+// PR, уже находящийся в next, возвращается как есть.
+func (s *PRService) getForTransition(ctx context.Context, prID string, next domain.PRStatus) (domain.PullRequest, error) {
+	pr, err := s.prRepo.Get(ctx, prID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPRNotFound) {
+			return domain.PullRequest{}, domain.ErrPRNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+
+	if pr.Status != next && !pr.Status.CanTransitionTo(next) {
+		return domain.PullRequest{}, transitionError(pr.Status, next)
+	}
+	return pr, nil
+}
+
+func transitionError(from, to domain.PRStatus) error {
+	return fmt.Errorf("%w: %s -> %s", domain.ErrInvalidTransition, from, to)
+}
+
+// requireOpen — менять ревьюверов и решения можно только у OPEN PR.
+func requireOpen(pr domain.PullRequest) error {
+	switch pr.Status {
+	case domain.PRStatusOpen:
+		return nil
+	case domain.PRStatusMerged:
+		return domain.ErrPRMerged
+	default:
+		return domain.ErrPRNotOpen
+	}
+}
+
+// ----------------- REVIEW DECISION -----------------
+
+func (s *PRService) SubmitReview(ctx context.Context, prID, reviewerID string, decision domain.ReviewDecision) (domain.PullRequest, error) {
+	return inTx(ctx, s.tx, func(ctx context.Context) (domain.PullRequest, error) {
+		return s.submitReview(ctx, prID, reviewerID, decision)
+	})
+}
+
+func (s *PRService) submitReview(ctx context.Context, prID, reviewerID string, decision domain.ReviewDecision) (domain.PullRequest, error) {
+	if !decision.Valid() {
+		return domain.PullRequest{}, domain.ErrInvalidDecision
+	}
+
+	pr, err := s.prRepo.Get(ctx, prID)
+	if err != nil {
 		if errors.Is(err, domain.ErrPRNotFound) {
 			return domain.PullRequest{}, domain.ErrPRNotFound
 		}
 		return domain.PullRequest{}, err
 	}
 
+	if err := requireOpen(pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if err := s.prRepo.SetDecision(ctx, prID, reviewerID, decision); err != nil {
+		if errors.Is(err, domain.ErrNotAssigned) {
+			return domain.PullRequest{}, domain.ErrNotAssigned
+		}
+		return domain.PullRequest{}, err
+	}
+
 	return s.prRepo.Get(ctx, prID)
 }
 
 // ----------------- REASSIGN REVIEWER -----------------
 func (s *PRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (domain.PullRequest, string, error) {
+	var (
+		pr    domain.PullRequest
+		newID string
+	)
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pr, newID, err = s.reassignReviewer(ctx, prID, oldReviewerID, domain.ReasonReassign)
+		return err
+	})
+	if err == nil {
+		s.metrics.ReviewerReassigned(domain.ReasonReassign)
+	}
+	return pr, newID, err
+}
+
+// reassignReviewer заменяет oldReviewerID; reason попадает в журнал назначений.
+func (s *PRService) reassignReviewer(ctx context.Context, prID, oldReviewerID string, reason domain.AssignmentReason) (domain.PullRequest, string, error) {
 	pr, err := s.prRepo.Get(ctx, prID)
 	if err != nil {
 		if errors.Is(err, domain.ErrPRNotFound) {
@@ -133,8 +639,8 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID st
 		return domain.PullRequest{}, "", err
 	}
 
-	if pr.Status == domain.PRStatusMerged {
-		return domain.PullRequest{}, "", domain.ErrPRMerged
+	if err := requireOpen(pr); err != nil {
+		return domain.PullRequest{}, "", err
 	}
 
 	assigned := false
@@ -150,59 +656,178 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID st
 		return domain.PullRequest{}, "", domain.ErrNotAssigned
 	}
 
-	oldUser, err := s.userRepo.Get(ctx, oldReviewerID)
+	// замену подбирают настройки команды автора, как при создании PR:
+	// её стратегия и цепочка fallback, а ревьювер не из неё получает отметку
+	author, err := s.userRepo.Get(ctx, pr.AuthorID)
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+	settings, err := s.teamSettings(ctx, author.TeamName)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) {
-			return domain.PullRequest{}, "", domain.ErrUserNotFound
-		}
 		return domain.PullRequest{}, "", err
 	}
 
-	users, err := s.userRepo.GetActiveUsersByTeam(ctx, oldUser.TeamName)
+	exclude := append([]string{oldReviewerID, pr.AuthorID}, otherReviewers...)
+	picked, err := s.pickReviewers(ctx, settings, 1, exclude...)
 	if err != nil {
 		return domain.PullRequest{}, "", err
 	}
+	newID := picked[0].ReviewerID
 
-	var candidates []string
-	for _, u := range users {
-		if u.ID == oldReviewerID {
-			continue
+	if err := s.prRepo.ReplaceReviewer(ctx, prID, oldReviewerID, picked[0]); err != nil {
+		if errors.Is(err, repository.ErrReviewerNotFound) {
+			return domain.PullRequest{}, "", domain.ErrNotAssigned
 		}
-		if u.ID == pr.AuthorID {
-			continue
+		return domain.PullRequest{}, "", err
+	}
+	if err := s.record(ctx, prID, domain.Review{ReviewerID: oldReviewerID},
+		domain.ActionUnassigned, reason, "replaced by "+newID); err != nil {
+		return domain.PullRequest{}, "", err
+	}
+	if err := s.record(ctx, prID, picked[0],
+		domain.ActionAssigned, reason, "replaces "+oldReviewerID); err != nil {
+		return domain.PullRequest{}, "", err
+	}
+	if err := s.outbox.Add(ctx, domain.Notification{
+		Kind:       domain.NotifyReviewerReassigned,
+		PRID:       prID,
+		UserID:     oldReviewerID,
+		ReplacedBy: newID,
+	}); err != nil {
+		return domain.PullRequest{}, "", err
+	}
+
+	updated, err := s.prRepo.Get(ctx, prID)
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+
+	return updated, newID, nil
+}
+
+// ----------------- REASSIGN FOR DEACTIVATED USERS -----------------
+
+// reassignForDeactivated снимает неактивных ревьюверов с открытых PR и
+// добирает состав до reviewer_count команды автора её стратегией.
+// Работает в транзакции вызывающего и возвращает, сколько ревьюверов
+// назначено взамен снятых: метрики считает он сам после фиксации.
+func (s *PRService) reassignForDeactivated(ctx context.Context, inactive []string) (int, error) {
+	prIDs, err := s.prRepo.GetOpenByReviewers(ctx, inactive)
+	if err != nil {
+		return 0, err
+	}
+
+	added := 0
+	gone := make(map[string]bool, len(inactive))
+	for _, id := range inactive {
+		gone[id] = true
+	}
+
+	for _, prID := range prIDs {
+		pr, err := s.prRepo.Get(ctx, prID)
+		if err != nil {
+			return 0, err
 		}
-		dup := false
-		for _, existing := range otherReviewers {
-			if existing == u.ID {
-				dup = true
-				break
+
+		var kept []string
+		for _, rID := range pr.Reviewers {
+			if !gone[rID] {
+				kept = append(kept, rID)
+				continue
 			}
+			if err := s.prRepo.RemoveReviewer(ctx, prID, rID); err != nil {
+				return 0, err
+			}
+			if err := s.record(ctx, prID, domain.Review{ReviewerID: rID},
+				domain.ActionUnassigned, domain.ReasonDeactivation, "reviewer deactivated"); err != nil {
+				return 0, err
+			}
+		}
+
+		author, err := s.userRepo.Get(ctx, pr.AuthorID)
+		if err != nil {
+			return 0, err
 		}
-		if dup {
+		settings, err := s.teamSettings(ctx, author.TeamName)
+		if err != nil {
+			return 0, err
+		}
+
+		need := settings.ReviewerCount - len(kept)
+		if need <= 0 {
 			continue
 		}
-		candidates = append(candidates, u.ID)
-	}
 
-	if len(candidates) == 0 {
-		return domain.PullRequest{}, "", domain.ErrNoCandidate
+		exclude := append([]string{pr.AuthorID}, kept...)
+		picked, err := s.pickReviewers(ctx, settings, need, exclude...)
+		if err != nil && !errors.Is(err, domain.ErrNoCandidate) {
+			return 0, err
+		}
+
+		for _, rv := range picked {
+			if err := s.prRepo.AddReviewer(ctx, prID, rv); err != nil {
+				return 0, err
+			}
+			if err := s.record(ctx, prID, rv,
+				domain.ActionAssigned, domain.ReasonDeactivation, "replaces deactivated reviewer"); err != nil {
+				return 0, err
+			}
+			if err := s.outbox.Add(ctx, domain.Notification{
+				Kind: domain.NotifyReviewerAssigned, PRID: prID, UserID: rv.ReviewerID,
+			}); err != nil {
+				return 0, err
+			}
+			added++
+		}
 	}
 
-	newID := candidates[s.rnd.Intn(len(candidates))]
+	return added, nil
+}
 
-	if err := s.prRepo.ReplaceReviewer(ctx, prID, oldReviewerID, newID); err != nil {
-		if err.Error() == "reviewer not found" {
-			return domain.PullRequest{}, "", domain.ErrNotAssigned
+// ----------------- ASSIGNMENT HISTORY -----------------
+
+// record пишет изменение назначения в журнал от имени актора из ctx.
+// Ревьювер из fallback-команды помечается в detail.
+func (s *PRService) record(ctx context.Context, prID string, rv domain.Review,
+	action domain.AssignmentAction, reason domain.AssignmentReason, detail string) error {
+	if rv.FallbackTeam != "" && action == domain.ActionAssigned {
+		fb := "fallback team " + rv.FallbackTeam
+		if detail != "" {
+			detail += ", " + fb
+		} else {
+			detail = fb
 		}
-		return domain.PullRequest{}, "", err
 	}
+	return s.events.Add(ctx, domain.AssignmentEvent{
+		PRID:   prID,
+		UserID: rv.ReviewerID,
+		Action: action,
+		Reason: reason,
+		Detail: detail,
+		Actor:  actorFrom(ctx),
+	})
+}
 
-	updated, err := s.prRepo.Get(ctx, prID)
-	if err != nil {
-		return domain.PullRequest{}, "", err
+// removeReviewers снимает всех ревьюверов pr с записью в журнал.
+func (s *PRService) removeReviewers(ctx context.Context, pr domain.PullRequest, reason domain.AssignmentReason) error {
+	if err := s.prRepo.RemoveReviewers(ctx, pr.ID); err != nil {
+		return err
+	}
+	for _, id := range pr.Reviewers {
+		if err := s.record(ctx, pr.ID, domain.Review{ReviewerID: id},
+			domain.ActionUnassigned, reason, ""); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return updated, newID, nil
+// History — журнал назначений PR в порядке записи.
+func (s *PRService) History(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	if _, err := s.prRepo.Get(ctx, prID); err != nil {
+		return nil, err
+	}
+	return s.events.ListByPR(ctx, prID)
 }
 
 // ----------------- GET PRs WHERE USER IS REVIEWER -----------------
@@ -211,6 +836,51 @@ func (s *PRService) GetUserReviews(ctx context.Context, userID string) ([]domain
 	return s.prRepo.GetForReviewer(ctx, userID)
 }
 
-func (s *PRService) Stats(ctx context.Context) (map[string]int, map[string]int, error) {
-	return s.prRepo.Stats(ctx)
+// ----------------- STATS -----------------
+
+func (s *PRService) Stats(ctx context.Context, filter domain.StatsFilter) (domain.Stats, error) {
+	if !filter.Valid() {
+		return domain.Stats{}, domain.ErrInvalidPeriod
+	}
+	return s.prRepo.Stats(ctx, filter)
+}
+
+// Turnaround — перцентили времени до merge и времени ревью
+// по командам и ревьюверам.
+func (s *PRService) Turnaround(ctx context.Context, filter domain.StatsFilter) (domain.Turnaround, error) {
+	if !filter.Valid() {
+		return domain.Turnaround{}, domain.ErrInvalidPeriod
+	}
+	samples, err := s.prRepo.Turnaround(ctx, filter)
+	if err != nil {
+		return domain.Turnaround{}, err
+	}
+	return samples.Aggregate(), nil
+}
+
+// Fairness — отчёт о равномерности назначений внутри команд.
+// Без периода берутся последние DefaultFairnessWeeks недель.
+func (s *PRService) Fairness(ctx context.Context, filter domain.StatsFilter) (domain.FairnessReport, error) {
+	if !filter.Valid() {
+		return domain.FairnessReport{}, domain.ErrInvalidPeriod
+	}
+
+	to := time.Now().UTC()
+	if filter.To != nil {
+		to = *filter.To
+	}
+	from := to.AddDate(0, 0, -7*domain.DefaultFairnessWeeks)
+	if filter.From != nil {
+		from = *filter.From
+	}
+	if !from.Before(to) {
+		return domain.FairnessReport{}, domain.ErrInvalidPeriod
+	}
+	filter.From, filter.To = &from, &to
+
+	counts, err := s.prRepo.AssignmentCounts(ctx, filter)
+	if err != nil {
+		return domain.FairnessReport{}, err
+	}
+	return domain.NewFairnessReport(counts, from, to), nil
 }
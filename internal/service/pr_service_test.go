@@ -0,0 +1,643 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+func TestCreateAssignsReviewersFromAuthorTeam(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+	e.addTeam(t, "platform", "p1")
+
+	pr := e.createPR(t, "pr-1", "author")
+
+	if pr.Status != domain.PRStatusOpen || len(pr.Reviewers) != testDefaultReviewers {
+		t.Fatalf("PR = %+v", pr)
+	}
+	for _, id := range pr.Reviewers {
+		if !slices.Contains([]string{"u1", "u2", "u3"}, id) {
+			t.Errorf("reviewer %s is not from the author's team", id)
+		}
+	}
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, pr.Reviewers...) {
+		t.Errorf("stored reviewers = %v, returned %v", got, pr.Reviewers)
+	}
+
+	history, err := e.prs.History(context.Background(), "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Reason != domain.ReasonAuto || history[0].Actor != SystemActor {
+		t.Errorf("history = %+v", history)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyReviewerAssigned)); n != 2 {
+		t.Errorf("assigned notifications = %d, want 2", n)
+	}
+	if e.metrics.created != 1 {
+		t.Errorf("created metric = %d, want 1", e.metrics.created)
+	}
+}
+
+func TestCreateSkipsUnavailableReviewers(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "inactive", "away", "u1")
+
+	if err := e.users.SetActive(ctx, "inactive", false); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	if _, err := e.users.AddAbsence(ctx, domain.Absence{
+		UserID: "away", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := e.createPR(t, "pr-1", "author")
+	if !sameIDs(pr.Reviewers, "u1") {
+		t.Errorf("reviewers = %v, want [u1]", pr.Reviewers)
+	}
+}
+
+// Позиция round-robin хранится в репозитории: новый PRService (рестарт
+// или другая реплика) продолжает очередь, а не начинает её заново.
+func TestRoundRobinSurvivesRestart(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerStrategy: domain.StrategyRoundRobin, ReviewerCount: 1})
+
+	var got []string
+	for i := range 4 {
+		e.wire()
+		pr := e.createPR(t, fmt.Sprintf("pr-%d", i), "author")
+		got = append(got, pr.Reviewers...)
+	}
+	if want := []string{"u1", "u2", "u3", "u1"}; !slices.Equal(got, want) {
+		t.Errorf("reviewers = %v, want %v", got, want)
+	}
+}
+
+// Выбор среди владельцев из CODEOWNERS не сдвигает очередь команды.
+func TestOwnersDoNotAdvanceTeamRoundRobin(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerStrategy: domain.StrategyRoundRobin, ReviewerCount: 1})
+	if _, _, err := e.teams.SetCodeowners(ctx, "backend", strings.NewReader("/api/ @u2 @u3\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []string{"u2", "u3"} {
+		pr, err := e.prs.Create(ctx, NewPR{ID: fmt.Sprintf("owned-%d", i), AuthorID: "author", Files: []string{"api/x.go"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sameIDs(pr.Reviewers, want) {
+			t.Fatalf("owned-%d reviewers = %v, want %s", i, pr.Reviewers, want)
+		}
+	}
+	// очередь команды начинается с начала
+	if pr := e.createPR(t, "plain", "author"); !sameIDs(pr.Reviewers, "u1") {
+		t.Errorf("reviewers = %v, want u1", pr.Reviewers)
+	}
+}
+
+func TestCreateWithoutCandidates(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author")
+
+	pr := e.createPR(t, "pr-1", "author")
+	if len(pr.Reviewers) != 0 {
+		t.Errorf("reviewers = %v, want none", pr.Reviewers)
+	}
+	if e.metrics.noCandidate != 1 {
+		t.Errorf("no_candidate metric = %d, want 1", e.metrics.noCandidate)
+	}
+}
+
+func TestCreateErrors(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "idle", "u1")
+	if err := e.users.SetActive(ctx, "idle", false); err != nil {
+		t.Fatal(err)
+	}
+	e.createPR(t, "pr-1", "author")
+
+	tests := []struct {
+		name string
+		in   NewPR
+		want error
+	}{
+		{"unknown author", NewPR{ID: "pr-2", AuthorID: "nobody"}, domain.ErrUserNotFound},
+		{"inactive author", NewPR{ID: "pr-2", AuthorID: "idle"}, domain.ErrUserNotActive},
+		{"duplicate id", NewPR{ID: "pr-1", AuthorID: "author"}, domain.ErrPRExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := e.prs.Create(ctx, tt.in); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if e.metrics.created != 1 {
+		t.Errorf("created metric = %d, want 1", e.metrics.created)
+	}
+}
+
+func TestCreateFallsBackToOtherTeams(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author", "u1")
+	e.addTeam(t, "platform", "p1")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"platform"}})
+
+	pr := e.createPR(t, "pr-1", "author")
+	if !sameIDs(pr.Reviewers, "u1", "p1") {
+		t.Fatalf("reviewers = %v, want u1 and p1", pr.Reviewers)
+	}
+	for _, rv := range e.getPR(t, "pr-1").Reviews {
+		want := ""
+		if rv.ReviewerID == "p1" {
+			want = "platform"
+		}
+		if rv.FallbackTeam != want {
+			t.Errorf("%s fallback team = %q, want %q", rv.ReviewerID, rv.FallbackTeam, want)
+		}
+	}
+}
+
+func TestDraftGetsReviewersWhenReady(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2")
+
+	pr, err := e.prs.Create(ctx, NewPR{ID: "pr-1", AuthorID: "author", Draft: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusDraft || len(pr.Reviewers) != 0 {
+		t.Fatalf("draft = %+v", pr)
+	}
+
+	pr, err = e.prs.MarkReady(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusOpen || !sameIDs(pr.Reviewers, "u1", "u2") {
+		t.Errorf("ready PR = %+v", pr)
+	}
+
+	pr, err = e.prs.MarkDraft(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusDraft || len(pr.Reviewers) != 0 {
+		t.Errorf("back to draft = %+v", pr)
+	}
+}
+
+func TestMergeIsIdempotent(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1")
+	e.createPR(t, "pr-1", "author")
+
+	first, err := e.prs.Merge(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Status != domain.PRStatusMerged || first.MergedAt == nil {
+		t.Fatalf("merged PR = %+v", first)
+	}
+
+	second, err := e.prs.Merge(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !second.MergedAt.Equal(*first.MergedAt) {
+		t.Errorf("repeated merge changed merged_at: %v -> %v", first.MergedAt, second.MergedAt)
+	}
+	if e.metrics.merged != 1 {
+		t.Errorf("merged metric = %d, want 1", e.metrics.merged)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyPRMerged)); n != 1 {
+		t.Errorf("merged notifications = %d, want 1", n)
+	}
+
+	if _, err := e.prs.Merge(ctx, "missing"); !errors.Is(err, domain.ErrPRNotFound) {
+		t.Errorf("merge of unknown PR: err = %v", err)
+	}
+}
+
+func TestMarkMergedExternally(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, MinApprovals: 2})
+	e.createPR(t, "pr-1", "author")
+
+	if _, err := e.prs.Merge(ctx, "pr-1"); !errors.Is(err, domain.ErrNotEnoughApprovals) {
+		t.Fatalf("merge without approvals: err = %v", err)
+	}
+
+	// merge у провайдера уже случился, одобрений не требуется
+	pr, err := e.prs.MarkMergedExternally(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusMerged || pr.MergedAt == nil {
+		t.Fatalf("merged PR = %+v", pr)
+	}
+	if _, err := e.prs.MarkMergedExternally(ctx, "pr-1"); err != nil {
+		t.Fatalf("repeated external merge: %v", err)
+	}
+	if e.metrics.merged != 1 {
+		t.Errorf("merged metric = %d, want 1", e.metrics.merged)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyPRMerged)); n != 1 {
+		t.Errorf("merged notifications = %d, want 1", n)
+	}
+
+	if _, err := e.prs.MarkMergedExternally(ctx, "missing"); !errors.Is(err, domain.ErrPRNotFound) {
+		t.Errorf("external merge of unknown PR: err = %v", err)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2")
+	e.createPR(t, "pr-1", "author")
+
+	pr, err := e.prs.Close(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusClosed || pr.ClosedAt == nil || len(pr.Reviewers) != 0 {
+		t.Fatalf("closed PR = %+v", pr)
+	}
+	if _, err := e.prs.Merge(ctx, "pr-1"); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("merge of closed PR: err = %v", err)
+	}
+	if _, err := e.prs.MarkReady(ctx, "pr-1"); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("ready from closed: err = %v", err)
+	}
+
+	pr, err = e.prs.Reopen(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Status != domain.PRStatusOpen || !sameIDs(pr.Reviewers, "u1", "u2") {
+		t.Errorf("reopened PR = %+v", pr)
+	}
+
+	if _, err := e.prs.Merge(ctx, "pr-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.prs.Close(ctx, "pr-1"); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("close of merged PR: err = %v", err)
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 1})
+	pr := e.createPR(t, "pr-1", "author")
+	reviewer := pr.Reviewers[0]
+	outsider := "u1"
+	if reviewer == outsider {
+		outsider = "u2"
+	}
+
+	tests := []struct {
+		name     string
+		reviewer string
+		decision domain.ReviewDecision
+		want     error
+	}{
+		{"unknown decision", reviewer, "LGTM", domain.ErrInvalidDecision},
+		{"not assigned", outsider, domain.DecisionApproved, domain.ErrNotAssigned},
+		{"approve", reviewer, domain.DecisionApproved, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := e.prs.SubmitReview(ctx, "pr-1", tt.reviewer, tt.decision); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if got := e.getPR(t, "pr-1").Approvals(); got != 1 {
+		t.Errorf("approvals = %d, want 1", got)
+	}
+
+	if _, err := e.prs.Merge(ctx, "pr-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.prs.SubmitReview(ctx, "pr-1", reviewer, domain.DecisionCommented); !errors.Is(err, domain.ErrPRMerged) {
+		t.Errorf("review of merged PR: err = %v", err)
+	}
+}
+
+// min_approvals не больше числа назначенных ревьюверов: иначе PR
+// с нехваткой кандидатов нельзя было бы влить никогда.
+func TestMergeWithFewerReviewersThanMinApprovals(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, MinApprovals: 2})
+
+	pr := e.createPR(t, "pr-1", "author")
+	if !sameIDs(pr.Reviewers, "u1") {
+		t.Fatalf("reviewers = %v, want [u1]", pr.Reviewers)
+	}
+	if _, err := e.prs.Merge(ctx, "pr-1"); !errors.Is(err, domain.ErrNotEnoughApprovals) {
+		t.Fatalf("merge without approvals: err = %v, want ErrNotEnoughApprovals", err)
+	}
+	if _, err := e.prs.SubmitReview(ctx, "pr-1", "u1", domain.DecisionApproved); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.prs.Merge(ctx, "pr-1"); err != nil {
+		t.Errorf("merge approved by every reviewer: %v", err)
+	}
+
+}
+
+// PR, на который не нашлось ни одного ревьювера, при min_approvals > 0
+// влить нельзя: правило не должно обходиться нехваткой кандидатов.
+func TestMergeWithoutReviewersIsRefused(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "solo", "lonely")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "solo", ReviewerCount: 2, MinApprovals: 2})
+
+	if pr := e.createPR(t, "pr-1", "lonely"); len(pr.Reviewers) != 0 {
+		t.Fatalf("reviewers = %v, want none", pr.Reviewers)
+	}
+	if _, err := e.prs.Merge(ctx, "pr-1"); !errors.Is(err, domain.ErrNotEnoughApprovals) {
+		t.Errorf("merge without reviewers: err = %v, want ErrNotEnoughApprovals", err)
+	}
+	if pr := e.getPR(t, "pr-1"); pr.Status != domain.PRStatusOpen {
+		t.Errorf("status = %s, want OPEN", pr.Status)
+	}
+
+	// без правила min_approvals PR без ревьюверов вливается как раньше
+	e.saveSettings(t, domain.TeamSettings{TeamName: "solo", ReviewerCount: 2})
+	if _, err := e.prs.Merge(ctx, "pr-1"); err != nil {
+		t.Errorf("merge without min_approvals: %v", err)
+	}
+}
+
+func TestReassignReviewer(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+	e.createPR(t, "pr-1", "author")
+	pr := e.getPR(t, "pr-1")
+	old, kept := pr.Reviewers[0], pr.Reviewers[1]
+
+	updated, newID, err := e.prs.ReassignReviewer(WithActor(ctx, "api"), "pr-1", old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// единственный свободный кандидат — не автор, не снятый и не второй ревьювер
+	free := slices.DeleteFunc([]string{"u1", "u2", "u3"}, func(id string) bool { return id == old || id == kept })
+	if newID != free[0] || !sameIDs(updated.Reviewers, kept, newID) {
+		t.Errorf("reassigned to %s, reviewers %v; want %s", newID, updated.Reviewers, free[0])
+	}
+	if e.metrics.reassigned[domain.ReasonReassign] != 1 {
+		t.Errorf("reassign metric = %v", e.metrics.reassigned)
+	}
+
+	history, err := e.prs.History(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := history[len(history)-2:]
+	if last[0].UserID != old || last[0].Action != domain.ActionUnassigned ||
+		last[1].UserID != newID || last[1].Action != domain.ActionAssigned || last[1].Actor != "api" {
+		t.Errorf("history tail = %+v", last)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyReviewerReassigned)); n != 1 {
+		t.Errorf("reassigned notifications = %d, want 1", n)
+	}
+
+	if _, _, err := e.prs.ReassignReviewer(ctx, "pr-1", old); !errors.Is(err, domain.ErrNotAssigned) {
+		t.Errorf("err = %v, want ErrNotAssigned", err)
+	}
+	// снятый ревьювер неактивен — заменить больше некем
+	if err := e.users.SetActive(ctx, old, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.prs.ReassignReviewer(ctx, "pr-1", kept); !errors.Is(err, domain.ErrNoCandidate) {
+		t.Errorf("err = %v, want ErrNoCandidate", err)
+	}
+	if e.metrics.reassigned[domain.ReasonReassign] != 1 {
+		t.Errorf("failed reassignments were counted: %v", e.metrics.reassigned)
+	}
+}
+
+func TestDeactivateTeamReassignsOpenReviews(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "b1")
+	e.addTeam(t, "frontend", "f1", "f2")
+	e.addTeam(t, "platform", "p1", "p2")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"frontend", "platform"}})
+
+	pr := e.createPR(t, "pr-1", "author")
+	if !slices.Contains(pr.Reviewers, "b1") {
+		t.Fatalf("reviewers = %v, want b1 among them", pr.Reviewers)
+	}
+
+	if err := e.admin.DeactivateTeam(ctx, "frontend"); err != nil {
+		t.Fatal(err)
+	}
+
+	pr = e.getPR(t, "pr-1")
+	if len(pr.Reviewers) != 2 || !slices.Contains(pr.Reviewers, "b1") {
+		t.Fatalf("reviewers after deactivation = %v", pr.Reviewers)
+	}
+	for _, rv := range pr.Reviews {
+		if rv.ReviewerID != "b1" && rv.FallbackTeam != "platform" {
+			t.Errorf("replacement %+v is not from platform", rv)
+		}
+	}
+	for _, id := range []string{"f1", "f2"} {
+		u, err := e.users.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.IsActive {
+			t.Errorf("%s is still active", id)
+		}
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyTeamDeactivated)); n != 1 {
+		t.Errorf("team deactivated notifications = %d, want 1", n)
+	}
+	if n := e.metrics.reassigned[domain.ReasonDeactivation]; n != 1 {
+		t.Errorf("deactivation reassignments = %d, want 1", n)
+	}
+}
+
+// failingCommit — Transactor, у которого внешняя транзакция
+// откатывается уже после того, как fn отработал без ошибок.
+type failingCommit struct {
+	repository.Transactor
+}
+
+func (f failingCommit) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return f.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		return errInjected
+	})
+}
+
+// Замены при деактивации попадают в метрики, только если транзакция
+// DeactivateTeam зафиксирована.
+func TestDeactivateTeamCountsReassignmentsAfterCommit(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "b1")
+	e.addTeam(t, "frontend", "f1", "f2")
+	e.addTeam(t, "platform", "p1", "p2")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"frontend", "platform"}})
+	e.createPR(t, "pr-1", "author")
+	before := e.getPR(t, "pr-1").Reviewers
+
+	admin := NewTeamAdminService(e.userRepo, e.prs, failingCommit{e.store}, e.outbox)
+	if err := admin.DeactivateTeam(ctx, "frontend"); !errors.Is(err, errInjected) {
+		t.Fatalf("err = %v, want the injected failure", err)
+	}
+	if n := e.metrics.reassigned[domain.ReasonDeactivation]; n != 0 {
+		t.Errorf("deactivation reassignments after rollback = %d, want 0", n)
+	}
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, before...) {
+		t.Errorf("reviewers after rollback = %v, want %v", got, before)
+	}
+
+	if err := e.admin.DeactivateTeam(ctx, "frontend"); err != nil {
+		t.Fatal(err)
+	}
+	if n := e.metrics.reassigned[domain.ReasonDeactivation]; n != 1 {
+		t.Errorf("deactivation reassignments = %d, want 1", n)
+	}
+}
+
+func TestReassignUsesAuthorTeamSettings(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "b1")
+	e.addTeam(t, "platform", "p1", "p2")
+	e.addTeam(t, "frontend", "f1")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 2, FallbackTeams: []string{"platform"}})
+	// у команды ревьювера свои настройки — при замене они не действуют
+	e.saveSettings(t, domain.TeamSettings{TeamName: "platform", ReviewerCount: 1, FallbackTeams: []string{"frontend"}})
+
+	pr := e.createPR(t, "pr-1", "author")
+	var old string
+	for _, id := range pr.Reviewers {
+		if id != "b1" {
+			old = id
+		}
+	}
+	if old == "" {
+		t.Fatalf("reviewers = %v, want b1 and a platform member", pr.Reviewers)
+	}
+
+	_, newID, err := e.prs.ReassignReviewer(ctx, "pr-1", old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "p1"
+	if old == "p1" {
+		want = "p2"
+	}
+	if newID != want {
+		t.Fatalf("replaced by %s, want %s", newID, want)
+	}
+	for _, rv := range e.getPR(t, "pr-1").Reviews {
+		if rv.ReviewerID == newID && rv.FallbackTeam != "platform" {
+			t.Errorf("replacement lost the fallback mark: %+v", rv)
+		}
+	}
+	history, err := e.prs.History(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := history[len(history)-1]; last.Detail != "replaces "+old+", fallback team platform" {
+		t.Errorf("history detail = %q", last.Detail)
+	}
+
+	// цепочка автора исчерпана: frontend из цепочки platform не используется
+	if err := e.users.SetActive(ctx, old, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := e.prs.ReassignReviewer(ctx, "pr-1", newID); !errors.Is(err, domain.ErrNoCandidate) {
+		t.Errorf("err = %v, want ErrNoCandidate", err)
+	}
+}
+
+// failingPRRepo — PRRepository, у которого n-й вызов AddReviewer
+// возвращает ошибку.
+type failingPRRepo struct {
+	repository.PRRepository
+	failOn int
+	calls  int
+}
+
+var errInjected = errors.New("injected failure")
+
+func (r *failingPRRepo) AddReviewer(ctx context.Context, prID string, rv domain.Review) error {
+	r.calls++
+	if r.calls == r.failOn {
+		return errInjected
+	}
+	return r.PRRepository.AddReviewer(ctx, prID, rv)
+}
+
+func TestCreateRollsBackOnReviewerFailure(t *testing.T) {
+	for failOn := 1; failOn <= testDefaultReviewers; failOn++ {
+		t.Run(fmt.Sprintf("reviewer %d", failOn), func(t *testing.T) {
+			e := newTestEnv(t)
+			ctx := context.Background()
+			e.addTeam(t, "backend", "author", "u1", "u2", "u3")
+			e.prRepo = &failingPRRepo{PRRepository: e.prRepo, failOn: failOn}
+			e.wire()
+
+			if _, err := e.prs.Create(ctx, NewPR{ID: "pr-1", AuthorID: "author"}); !errors.Is(err, errInjected) {
+				t.Fatalf("err = %v, want the injected failure", err)
+			}
+
+			if _, err := e.prRepo.Get(ctx, "pr-1"); !errors.Is(err, domain.ErrPRNotFound) {
+				t.Errorf("PR exists after failed Create: %v", err)
+			}
+			if events, _ := e.events.ListByPR(ctx, "pr-1"); len(events) != 0 {
+				t.Errorf("assignment history after failed Create: %+v", events)
+			}
+			if n := len(e.pendingNotifications(t, domain.NotifyReviewerAssigned)); n != 0 {
+				t.Errorf("notifications after failed Create: %d", n)
+			}
+			if e.metrics.created != 0 {
+				t.Errorf("created metric = %d, want 0", e.metrics.created)
+			}
+
+			// тот же id можно создать снова
+			if _, err := e.prs.Create(ctx, NewPR{ID: "pr-1", AuthorID: "author"}); err != nil {
+				t.Errorf("retry: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+)
+
+// testEnv — сервисы поверх одного memory.Store.
+type testEnv struct {
+	store       *memory.Store
+	teamRepo    repository.TeamRepository
+	userRepo    repository.UserRepository
+	prRepo      repository.PRRepository
+	escalations repository.EscalationRepository
+	outbox      repository.OutboxRepository
+	events      repository.AssignmentEventRepository
+
+	prs     *PRService
+	teams   *TeamService
+	users   *UserService
+	admin   *TeamAdminService
+	sla     *SLAService
+	metrics *countingMetrics
+}
+
+const testDefaultReviewers = 2
+
+var (
+	farFuture = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	errPeek   = errors.New("peek")
+)
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	s := memory.NewStore()
+	e := &testEnv{
+		store:       s,
+		teamRepo:    memory.NewTeamRepository(s),
+		userRepo:    memory.NewUserRepository(s),
+		prRepo:      memory.NewPRRepository(s),
+		escalations: memory.NewEscalationRepository(s),
+		outbox:      memory.NewOutboxRepository(s),
+		events:      memory.NewAssignmentEventRepository(s),
+		metrics:     &countingMetrics{reassigned: map[domain.AssignmentReason]int{}},
+	}
+	e.wire()
+	return e
+}
+
+// wire собирает сервисы из репозиториев e; тест может подменить
+// репозиторий и вызвать wire заново.
+func (e *testEnv) wire() {
+	e.prs = NewPRService(e.prRepo, e.userRepo, e.teamRepo, e.store, e.outbox, e.events,
+		testDefaultReviewers, e.metrics)
+	e.teams = NewTeamService(e.teamRepo, e.store, testDefaultReviewers)
+	e.users = NewUserService(e.userRepo, e.store)
+	e.admin = NewTeamAdminService(e.userRepo, e.prs, e.store, e.outbox)
+	e.sla = NewSLAService(e.escalations, e.prs, e.outbox, e.store)
+}
+
+// addTeam создаёт команду с активными участниками.
+func (e *testEnv) addTeam(t *testing.T, name string, members ...string) {
+	t.Helper()
+	team := &domain.Team{Name: name}
+	for _, id := range members {
+		team.Members = append(team.Members, domain.TeamMember{ID: id, Username: "name-" + id, IsActive: true})
+	}
+	if err := e.teams.CreateWithMembers(context.Background(), team); err != nil {
+		t.Fatalf("create team %s: %v", name, err)
+	}
+}
+
+func (e *testEnv) saveSettings(t *testing.T, settings domain.TeamSettings) {
+	t.Helper()
+	if settings.ReviewerStrategy == "" {
+		settings.ReviewerStrategy = domain.StrategyRandom
+	}
+	if _, err := e.teams.UpdateSettings(context.Background(), settings); err != nil {
+		t.Fatalf("save settings of %s: %v", settings.TeamName, err)
+	}
+}
+
+func (e *testEnv) createPR(t *testing.T, id, author string) domain.PullRequest {
+	t.Helper()
+	pr, err := e.prs.Create(context.Background(), NewPR{ID: id, Name: "name " + id, AuthorID: author})
+	if err != nil {
+		t.Fatalf("create PR %s: %v", id, err)
+	}
+	return pr
+}
+
+func (e *testEnv) getPR(t *testing.T, id string) domain.PullRequest {
+	t.Helper()
+	pr, err := e.prRepo.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get PR %s: %v", id, err)
+	}
+	return pr
+}
+
+// pendingNotifications — недоставленные уведомления заданного вида.
+func (e *testEnv) pendingNotifications(t *testing.T, kind domain.NotificationKind) []domain.Notification {
+	t.Helper()
+	var res []domain.Notification
+	err := e.store.WithinTx(context.Background(), func(ctx context.Context) error {
+		events, err := e.outbox.LeasePending(ctx, farFuture, farFuture, 1000)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if ev.Kind == kind {
+				res = append(res, ev.Notification)
+			}
+		}
+		// только посмотреть: откат возвращает аренду
+		return errPeek
+	})
+	if err != errPeek {
+		t.Fatalf("read outbox: %v", err)
+	}
+	return res
+}
+
+// countingMetrics считает вызовы Metrics.
+type countingMetrics struct {
+	mu          sync.Mutex
+	created     int
+	merged      int
+	noCandidate int
+	reassigned  map[domain.AssignmentReason]int
+}
+
+func (m *countingMetrics) PRCreated() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.created++
+}
+
+func (m *countingMetrics) PRMerged() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.merged++
+}
+
+func (m *countingMetrics) ReviewerReassigned(reason domain.AssignmentReason) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reassigned[reason]++
+}
+
+func (m *countingMetrics) NoCandidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.noCandidate++
+}
+
+func sameIDs(got []string, want ...string) bool {
+	got, want = slices.Clone(got), slices.Clone(want)
+	slices.Sort(got)
+	slices.Sort(want)
+	return slices.Equal(got, want)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+// SLAService следит за SLA ревью: напоминает ревьюверам, которые не
+// высказались за review_sla_hours, и заменяет их после escalation_hours.
+type SLAService struct {
+	repo   repository.EscalationRepository
+	prs    *PRService
+	outbox repository.OutboxRepository
+	tx     repository.Transactor
+}
+
+func NewSLAService(
+	repo repository.EscalationRepository,
+	prs *PRService,
+	outbox repository.OutboxRepository,
+	tx repository.Transactor,
+) *SLAService {
+	return &SLAService{repo: repo, prs: prs, outbox: outbox, tx: tx}
+}
+
+// Run проверяет SLA каждые interval, пока ctx не отменён.
+func (s *SLAService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Check(ctx, time.Now().UTC()); err != nil {
+				log.Printf("sla check: %v", err)
+			}
+		}
+	}
+}
+
+// Check — один проход по просроченным назначениям на момент now.
+// Ошибка по одному назначению не останавливает остальные.
+func (s *SLAService) Check(ctx context.Context, now time.Time) error {
+	ctx = WithActor(ctx, "sla")
+
+	overdue, err := s.repo.Overdue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range overdue {
+		if o.EscalateAfter > 0 && now.Sub(o.AssignedAt) >= o.EscalateAfter {
+			err := s.escalate(ctx, o)
+			if err == nil {
+				continue
+			}
+			// заменить некем — хотя бы напоминаем
+			if !errors.Is(err, domain.ErrNoCandidate) {
+				log.Printf("sla escalate %s/%s: %v", o.PRID, o.ReviewerID, err)
+				continue
+			}
+		}
+		if o.Reminded {
+			continue
+		}
+		if err := s.remind(ctx, o); err != nil {
+			log.Printf("sla remind %s/%s: %v", o.PRID, o.ReviewerID, err)
+		}
+	}
+	return nil
+}
+
+func (s *SLAService) remind(ctx context.Context, o domain.OverdueReview) error {
+	_, err := inTx(ctx, s.tx, func(ctx context.Context) (domain.Escalation, error) {
+		e, err := s.repo.Record(ctx, domain.Escalation{
+			PRID:       o.PRID,
+			ReviewerID: o.ReviewerID,
+			Kind:       domain.EscalationReminder,
+			AssignedAt: o.AssignedAt,
+			Reason:     fmt.Sprintf("no decision within %dh", int(o.SLA.Hours())),
+		})
+		if err != nil {
+			return e, err
+		}
+		return e, s.outbox.Add(ctx, domain.Notification{
+			Kind:   domain.NotifyReviewReminder,
+			PRID:   e.PRID,
+			UserID: e.ReviewerID,
+			Reason: e.Reason,
+			At:     e.CreatedAt,
+		})
+	})
+	return err
+}
+
+// escalate заменяет ревьювера той же логикой, что и ручной reassign,
+// и записывает причину в одной транзакции с заменой.
+func (s *SLAService) escalate(ctx context.Context, o domain.OverdueReview) error {
+	_, err := inTx(ctx, s.tx, func(ctx context.Context) (domain.Escalation, error) {
+		_, newID, err := s.prs.reassignReviewer(ctx, o.PRID, o.ReviewerID, domain.ReasonSLAEscalation)
+		if err != nil {
+			return domain.Escalation{}, err
+		}
+		e, err := s.repo.Record(ctx, domain.Escalation{
+			PRID:       o.PRID,
+			ReviewerID: o.ReviewerID,
+			Kind:       domain.EscalationReassign,
+			AssignedAt: o.AssignedAt,
+			ReplacedBy: newID,
+			Reason:     fmt.Sprintf("no decision within %dh", int(o.EscalateAfter.Hours())),
+		})
+		if err != nil {
+			return e, err
+		}
+		return e, s.outbox.Add(ctx, domain.Notification{
+			Kind:       domain.NotifyReviewEscalated,
+			PRID:       e.PRID,
+			UserID:     e.ReviewerID,
+			ReplacedBy: e.ReplacedBy,
+			Reason:     e.Reason,
+			At:         e.CreatedAt,
+		})
+	})
+	if err == nil {
+		s.prs.metrics.ReviewerReassigned(domain.ReasonSLAEscalation)
+	}
+	return err
+}
+
+// Escalations — история напоминаний и переназначений по PR.
+func (s *SLAService) Escalations(ctx context.Context, prID string) ([]domain.Escalation, error) {
+	if _, err := s.prs.prRepo.Get(ctx, prID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPR(ctx, prID)
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+)
+
+const (
+	testSLAHours        = 4
+	testEscalationHours = 8
+)
+
+// slaEnv — команда backend с SLA: author и трое ревьюверов, по одному на PR.
+func slaEnv(t *testing.T, escalationHours int) *testEnv {
+	t.Helper()
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author", "r1", "r2", "r3")
+	e.saveSettings(t, domain.TeamSettings{
+		TeamName:        "backend",
+		ReviewerCount:   1,
+		ReviewSLAHours:  testSLAHours,
+		EscalationHours: escalationHours,
+	})
+	return e
+}
+
+// hoursLater — момент проверки через h часов (и минуту) после назначений,
+// сделанных до вызова.
+func hoursLater(h int) time.Time {
+	return time.Now().Add(time.Duration(h)*time.Hour + time.Minute)
+}
+
+func (e *testEnv) checkSLA(t *testing.T, now time.Time) {
+	t.Helper()
+	if err := e.sla.Check(context.Background(), now); err != nil {
+		t.Fatalf("check SLA: %v", err)
+	}
+}
+
+func (e *testEnv) escalationsOf(t *testing.T, prID string) []domain.Escalation {
+	t.Helper()
+	res, err := e.sla.Escalations(context.Background(), prID)
+	if err != nil {
+		t.Fatalf("escalations of %s: %v", prID, err)
+	}
+	return res
+}
+
+func TestSLAReminderIsSentOnce(t *testing.T) {
+	e := slaEnv(t, 0)
+	start := time.Now()
+	pr := e.createPR(t, "pr-1", "author")
+	reviewer := pr.Reviewers[0]
+
+	// до review_sla_hours — тишина
+	e.checkSLA(t, start.Add(testSLAHours*time.Hour))
+	if got := e.escalationsOf(t, "pr-1"); len(got) != 0 {
+		t.Fatalf("escalations before SLA = %+v", got)
+	}
+
+	for _, h := range []int{testSLAHours, testSLAHours + 1, 100} {
+		e.checkSLA(t, hoursLater(h))
+	}
+
+	got := e.escalationsOf(t, "pr-1")
+	if len(got) != 1 || got[0].Kind != domain.EscalationReminder || got[0].ReviewerID != reviewer {
+		t.Fatalf("escalations = %+v, want one reminder to %s", got, reviewer)
+	}
+	reminders := e.pendingNotifications(t, domain.NotifyReviewReminder)
+	if len(reminders) != 1 || reminders[0].UserID != reviewer || reminders[0].PRID != "pr-1" {
+		t.Errorf("reminders = %+v", reminders)
+	}
+	// escalation_hours = 0: ревьювера не заменяют
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, reviewer) {
+		t.Errorf("reviewers = %v, want %s", got, reviewer)
+	}
+}
+
+// Решение ревьювера снимает назначение с контроля SLA.
+func TestSLASkipsDecidedReviews(t *testing.T) {
+	e := slaEnv(t, testEscalationHours)
+	pr := e.createPR(t, "pr-1", "author")
+	if _, err := e.prs.SubmitReview(context.Background(), "pr-1", pr.Reviewers[0], domain.DecisionCommented); err != nil {
+		t.Fatal(err)
+	}
+
+	e.checkSLA(t, hoursLater(testEscalationHours))
+	if got := e.escalationsOf(t, "pr-1"); len(got) != 0 {
+		t.Errorf("escalations = %+v, want none", got)
+	}
+}
+
+func TestSLAEscalation(t *testing.T) {
+	e := slaEnv(t, testEscalationHours)
+	start := time.Now()
+	pr := e.createPR(t, "pr-1", "author")
+	old := pr.Reviewers[0]
+
+	e.checkSLA(t, hoursLater(testSLAHours))
+	e.checkSLA(t, hoursLater(testEscalationHours-1))
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, old) {
+		t.Fatalf("reviewers before escalation_hours = %v, want %s", got, old)
+	}
+
+	e.checkSLA(t, hoursLater(testEscalationHours))
+
+	reviewers := e.getPR(t, "pr-1").Reviewers
+	if len(reviewers) != 1 || reviewers[0] == old || reviewers[0] == "author" {
+		t.Fatalf("reviewers after escalation = %v, want someone other than %s", reviewers, old)
+	}
+	got := e.escalationsOf(t, "pr-1")
+	if len(got) != 2 || got[0].Kind != domain.EscalationReminder ||
+		got[1].Kind != domain.EscalationReassign || got[1].ReviewerID != old || got[1].ReplacedBy != reviewers[0] {
+		t.Fatalf("escalations = %+v, want a reminder and a reassign of %s", got, old)
+	}
+	escalated := e.pendingNotifications(t, domain.NotifyReviewEscalated)
+	if len(escalated) != 1 || escalated[0].UserID != old || escalated[0].ReplacedBy != reviewers[0] {
+		t.Errorf("escalation notifications = %+v", escalated)
+	}
+	if n := e.metrics.reassigned[domain.ReasonSLAEscalation]; n != 1 {
+		t.Errorf("sla reassignments = %d, want 1", n)
+	}
+
+	// старый ревьювер больше не назначен, новый ещё в пределах SLA
+	e.checkSLA(t, start.Add(testSLAHours*time.Hour))
+	if got := e.escalationsOf(t, "pr-1"); len(got) != 2 {
+		t.Errorf("escalations after repeated check = %+v", got)
+	}
+}
+
+// Заменить некем — вместо замены напоминание, один раз.
+func TestSLAEscalationFallsBackToReminder(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "author", "r1")
+	e.saveSettings(t, domain.TeamSettings{
+		TeamName:        "backend",
+		ReviewerCount:   1,
+		ReviewSLAHours:  testSLAHours,
+		EscalationHours: testEscalationHours,
+	})
+	e.createPR(t, "pr-1", "author")
+
+	e.checkSLA(t, hoursLater(testEscalationHours))
+	e.checkSLA(t, hoursLater(testEscalationHours+1))
+
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, "r1") {
+		t.Errorf("reviewers = %v, want r1", got)
+	}
+	got := e.escalationsOf(t, "pr-1")
+	if len(got) != 1 || got[0].Kind != domain.EscalationReminder || got[0].ReviewerID != "r1" {
+		t.Errorf("escalations = %+v, want one reminder to r1", got)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyReviewReminder)); n != 1 {
+		t.Errorf("reminders = %d, want 1", n)
+	}
+}
+
+// failingEscalations — EscalationRepository, не записывающий замены.
+type failingEscalations struct {
+	repository.EscalationRepository
+}
+
+func (r failingEscalations) Record(ctx context.Context, esc domain.Escalation) (domain.Escalation, error) {
+	if esc.Kind == domain.EscalationReassign {
+		return domain.Escalation{}, errInjected
+	}
+	return r.EscalationRepository.Record(ctx, esc)
+}
+
+// Прочие ошибки замены не превращаются в напоминание, а замена
+// откатывается целиком.
+func TestSLAEscalationFailureDoesNotRemind(t *testing.T) {
+	e := slaEnv(t, testEscalationHours)
+	e.escalations = failingEscalations{e.escalations}
+	e.wire()
+	pr := e.createPR(t, "pr-1", "author")
+
+	e.checkSLA(t, hoursLater(testEscalationHours))
+
+	if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, pr.Reviewers...) {
+		t.Errorf("reviewers = %v, want %v", got, pr.Reviewers)
+	}
+	if got := e.escalationsOf(t, "pr-1"); len(got) != 0 {
+		t.Errorf("escalations = %+v, want none", got)
+	}
+	if n := len(e.pendingNotifications(t, domain.NotifyReviewReminder)); n != 0 {
+		t.Errorf("reminders = %d, want 0", n)
+	}
+	if n := e.metrics.reassigned[domain.ReasonSLAEscalation]; n != 0 {
+		t.Errorf("sla reassignments = %d, want 0", n)
+	}
+}
+
+func TestSLASkipsPRsNotOpen(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		apply func(e *testEnv, id string) error
+	}{
+		{"draft", func(e *testEnv, id string) error { _, err := e.prs.MarkDraft(ctx, id); return err }},
+		{"merged", func(e *testEnv, id string) error { _, err := e.prs.Merge(ctx, id); return err }},
+		{"closed", func(e *testEnv, id string) error { _, err := e.prs.Close(ctx, id); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := slaEnv(t, testEscalationHours)
+			e.createPR(t, "pr-1", "author")
+			if err := tt.apply(e, "pr-1"); err != nil {
+				t.Fatal(err)
+			}
+			before := e.getPR(t, "pr-1").Reviewers
+
+			e.checkSLA(t, hoursLater(testEscalationHours))
+
+			if got := e.escalationsOf(t, "pr-1"); len(got) != 0 {
+				t.Errorf("escalations = %+v, want none", got)
+			}
+			if got := e.getPR(t, "pr-1").Reviewers; !sameIDs(got, before...) {
+				t.Errorf("reviewers = %v, want %v", got, before)
+			}
+			if n := len(e.pendingNotifications(t, domain.NotifyReviewReminder)); n != 0 {
+				t.Errorf("reminders = %d, want 0", n)
+			}
+		})
+	}
+}
@@ -2,28 +2,50 @@ package service
 
 import (
 	"context"
+	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/repository"
 )
 
 type TeamAdminService struct {
-	users repository.UserRepository
-	prs   repository.PRRepository
+	users  repository.UserRepository
+	prs    *PRService
+	tx     repository.Transactor
+	outbox repository.OutboxRepository
 }
 
 func NewTeamAdminService(
 	users repository.UserRepository,
-	prs repository.PRRepository,
+	prs *PRService,
+	tx repository.Transactor,
+	outbox repository.OutboxRepository,
 ) *TeamAdminService {
-	return &TeamAdminService{users: users, prs: prs}
+	return &TeamAdminService{users: users, prs: prs, tx: tx, outbox: outbox}
 }
 
+// DeactivateTeam деактивирует команду и переназначает её открытые ревью
+// одной транзакцией: при ошибке никто не остаётся деактивированным
+// с «висящими» назначениями. Замены попадают в метрики после фиксации.
 func (s *TeamAdminService) DeactivateTeam(ctx context.Context, team string) error {
-	users, err := s.users.GetActiveUsersByTeam(ctx, team)
+	added, err := inTx(ctx, s.tx, func(ctx context.Context) (int, error) {
+		return s.deactivateTeam(ctx, team)
+	})
 	if err != nil {
 		return err
 	}
+	for range added {
+		s.prs.metrics.ReviewerReassigned(domain.ReasonDeactivation)
+	}
+	return nil
+}
+
+// deactivateTeam возвращает число назначенных взамен ревьюверов.
+func (s *TeamAdminService) deactivateTeam(ctx context.Context, team string) (int, error) {
+	users, err := s.users.GetActiveUsersByTeam(ctx, team)
+	if err != nil {
+		return 0, err
+	}
 	if len(users) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	var ids []string
@@ -32,8 +54,13 @@ func (s *TeamAdminService) DeactivateTeam(ctx context.Context, team string) erro
 	}
 
 	if err := s.users.DeactivateMany(ctx, ids); err != nil {
-		return err
+		return 0, err
+	}
+	if err := s.outbox.Add(ctx, domain.Notification{
+		Kind: domain.NotifyTeamDeactivated, TeamName: team, UserIDs: ids,
+	}); err != nil {
+		return 0, err
 	}
 
-	return s.prs.ReassignForDeactivated(ctx, ids)
+	return s.prs.reassignForDeactivated(ctx, ids)
 }
@@ -3,16 +3,25 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"pr-reviewer-service/internal/codeowners"
 	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/repository"
 )
 
 type TeamService struct {
 	repo repository.TeamRepository
+	tx   repository.Transactor
+	// defaultReviewers — число ревьюверов у команды без сохранённых настроек.
+	defaultReviewers int
 }
 
-func NewTeamService(repo repository.TeamRepository) *TeamService {
-	return &TeamService{repo: repo}
+func NewTeamService(repo repository.TeamRepository, tx repository.Transactor, defaultReviewers int) *TeamService {
+	return &TeamService{repo: repo, tx: tx, defaultReviewers: defaultReviewers}
 }
 
 func (s *TeamService) Create(ctx context.Context, name string) error {
@@ -30,12 +39,130 @@ func (s *TeamService) Get(ctx context.Context, name string) (*domain.Team, error
 	return team, nil
 }
 func (s *TeamService) CreateWithMembers(ctx context.Context, team *domain.Team) error {
-	if err := s.repo.Create(ctx, team.Name); err != nil {
-		if errors.Is(err, repository.ErrTeamExists) {
-			return domain.ErrTeamExists
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, team.Name); err != nil {
+			if errors.Is(err, repository.ErrTeamExists) {
+				return domain.ErrTeamExists
+			}
+			return err
+		}
+
+		return s.repo.AddMembers(ctx, team.Name, team.Members)
+	})
+}
+
+func (s *TeamService) GetSettings(ctx context.Context, name string) (domain.TeamSettings, error) {
+	return loadSettings(ctx, s.repo, name, s.defaultReviewers)
+}
+
+// loadSettings — сохранённые настройки команды, а если их нет — настройки
+// по умолчанию с defaultReviewers ревьюверами.
+func loadSettings(ctx context.Context, repo repository.TeamRepository, name string, defaultReviewers int) (domain.TeamSettings, error) {
+	settings, err := repo.GetSettings(ctx, name)
+	switch {
+	case errors.Is(err, repository.ErrNoSettings):
+		return domain.DefaultTeamSettings(name, defaultReviewers), nil
+	case errors.Is(err, repository.ErrTeamNotFound):
+		return domain.TeamSettings{}, domain.ErrTeamNotFound
+	case err != nil:
+		return domain.TeamSettings{}, err
+	}
+	return settings, nil
+}
+
+func (s *TeamService) UpdateSettings(ctx context.Context, settings domain.TeamSettings) (domain.TeamSettings, error) {
+	switch settings.ReviewerStrategy {
+	case domain.StrategyRandom, domain.StrategyRoundRobin,
+		domain.StrategyLeastLoaded, domain.StrategyWeighted:
+	default:
+		return domain.TeamSettings{}, domain.ErrUnknownStrategy
+	}
+	if settings.ReviewerCount < 0 || settings.ReviewerCount > domain.MaxReviewerCount {
+		return domain.TeamSettings{}, fmt.Errorf("%w: reviewer_count must be between 0 and %d",
+			domain.ErrInvalidSettings, domain.MaxReviewerCount)
+	}
+	if settings.MinApprovals < 0 || settings.MinApprovals > settings.ReviewerCount {
+		return domain.TeamSettings{}, fmt.Errorf("%w: min_approvals must be between 0 and reviewer_count",
+			domain.ErrInvalidSettings)
+	}
+	if settings.ReviewSLAHours < 0 || settings.EscalationHours < 0 {
+		return domain.TeamSettings{}, fmt.Errorf("%w: review_sla_hours and escalation_hours must not be negative",
+			domain.ErrInvalidSettings)
+	}
+	if settings.EscalationHours > 0 && settings.EscalationHours <= settings.ReviewSLAHours {
+		return domain.TeamSettings{}, fmt.Errorf("%w: escalation_hours must be greater than review_sla_hours",
+			domain.ErrInvalidSettings)
+	}
+
+	for _, fb := range settings.FallbackTeams {
+		if fb == domain.AnyTeam {
+			continue
+		}
+		if fb == settings.TeamName {
+			return domain.TeamSettings{}, fmt.Errorf("%w: team cannot fall back to itself",
+				domain.ErrInvalidSettings)
+		}
+		if _, err := s.repo.Get(ctx, fb); err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				return domain.TeamSettings{}, fmt.Errorf("%w: fallback team %q not found",
+					domain.ErrInvalidSettings, fb)
+			}
+			return domain.TeamSettings{}, err
 		}
-		return err
 	}
 
-	return s.repo.AddMembers(ctx, team.Name, team.Members)
+	if err := s.repo.SaveSettings(ctx, settings); err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.TeamSettings{}, domain.ErrTeamNotFound
+		}
+		return domain.TeamSettings{}, err
+	}
+	return settings, nil
+}
+
+// ----------------- CODEOWNERS -----------------
+
+// GetCodeowners — файл владения команды и разобранные из него правила.
+func (s *TeamService) GetCodeowners(ctx context.Context, team string) (domain.TeamCodeowners, codeowners.Ruleset, error) {
+	co, err := s.repo.GetCodeowners(ctx, team)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.TeamCodeowners{}, nil, domain.ErrTeamNotFound
+		}
+		return domain.TeamCodeowners{}, nil, err
+	}
+
+	rules, err := codeowners.Parse(strings.NewReader(co.Content))
+	if err != nil {
+		return domain.TeamCodeowners{}, nil, err
+	}
+	return co, rules, nil
+}
+
+// SetCodeowners заменяет файл владения команды. Файл с синтаксическими
+// ошибками не сохраняется; владельцы, которых нет в сервисе, допустимы —
+// при подборе ревьюверов они пропускаются.
+func (s *TeamService) SetCodeowners(ctx context.Context, team string, r io.Reader) (domain.TeamCodeowners, codeowners.Ruleset, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return domain.TeamCodeowners{}, nil, err
+	}
+
+	rules, err := codeowners.Parse(strings.NewReader(string(content)))
+	if err != nil {
+		return domain.TeamCodeowners{}, nil, fmt.Errorf("%w: %v", domain.ErrInvalidCodeowners, err)
+	}
+
+	co := domain.TeamCodeowners{
+		TeamName:  team,
+		Content:   string(content),
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.SaveCodeowners(ctx, co); err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.TeamCodeowners{}, nil, domain.ErrTeamNotFound
+		}
+		return domain.TeamCodeowners{}, nil, err
+	}
+	return co, rules, nil
 }
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+func TestTeamSettingsDefaults(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "u1")
+
+	got, err := e.teams.GetSettings(context.Background(), "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ReviewerStrategy != domain.StrategyRandom || got.ReviewerCount != testDefaultReviewers {
+		t.Errorf("default settings = %+v", got)
+	}
+
+	if _, err := e.teams.GetSettings(context.Background(), "missing"); !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Errorf("settings of unknown team: err = %v", err)
+	}
+}
+
+func TestUpdateSettingsValidation(t *testing.T) {
+	e := newTestEnv(t)
+	e.addTeam(t, "backend", "u1")
+	e.addTeam(t, "platform", "p1")
+
+	valid := domain.TeamSettings{
+		TeamName:         "backend",
+		ReviewerStrategy: domain.StrategyLeastLoaded,
+		ReviewerCount:    2,
+		MinApprovals:     1,
+		FallbackTeams:    []string{"platform", domain.AnyTeam},
+		ReviewSLAHours:   4,
+		EscalationHours:  8,
+	}
+	with := func(fn func(s *domain.TeamSettings)) domain.TeamSettings {
+		s := valid
+		fn(&s)
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		settings domain.TeamSettings
+		want     error
+	}{
+		{"valid", valid, nil},
+		{"unknown strategy", with(func(s *domain.TeamSettings) { s.ReviewerStrategy = "lottery" }), domain.ErrUnknownStrategy},
+		{"negative count", with(func(s *domain.TeamSettings) { s.ReviewerCount = -1 }), domain.ErrInvalidSettings},
+		{"count above max", with(func(s *domain.TeamSettings) { s.ReviewerCount = domain.MaxReviewerCount + 1 }), domain.ErrInvalidSettings},
+		{"approvals above count", with(func(s *domain.TeamSettings) { s.MinApprovals = 3 }), domain.ErrInvalidSettings},
+		{"negative sla", with(func(s *domain.TeamSettings) { s.ReviewSLAHours = -1 }), domain.ErrInvalidSettings},
+		{"escalation before sla", with(func(s *domain.TeamSettings) { s.EscalationHours = 4 }), domain.ErrInvalidSettings},
+		{"fallback to itself", with(func(s *domain.TeamSettings) { s.FallbackTeams = []string{"backend"} }), domain.ErrInvalidSettings},
+		{"unknown fallback", with(func(s *domain.TeamSettings) { s.FallbackTeams = []string{"missing"} }), domain.ErrInvalidSettings},
+		{"unknown team", with(func(s *domain.TeamSettings) { s.TeamName = "missing" }), domain.ErrTeamNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := e.teams.UpdateSettings(context.Background(), tt.settings); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// ошибки не затирают сохранённые настройки
+	got, err := e.teams.GetSettings(context.Background(), "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ReviewerStrategy != valid.ReviewerStrategy || got.MinApprovals != valid.MinApprovals {
+		t.Errorf("settings = %+v, want %+v", got, valid)
+	}
+}
+
+func TestCreateWithMembersIsAtomic(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "u1")
+
+	err := e.teams.CreateWithMembers(ctx, &domain.Team{Name: "platform", Members: []domain.TeamMember{
+		{ID: "p1", Username: "p1", IsActive: true},
+		{ID: "u1", Username: "u1", IsActive: true},
+	}})
+	if err == nil {
+		t.Fatal("want error for a user from another team")
+	}
+	if _, err := e.teams.Get(ctx, "platform"); !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Errorf("team was created despite the error: %v", err)
+	}
+	if _, err := e.users.Get(ctx, "p1"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("member was created despite the error: %v", err)
+	}
+
+	if err := e.teams.CreateWithMembers(ctx, &domain.Team{Name: "backend"}); !errors.Is(err, domain.ErrTeamExists) {
+		t.Errorf("duplicate team: err = %v", err)
+	}
+}
+
+func TestCodeowners(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "author", "u1", "u2")
+	e.saveSettings(t, domain.TeamSettings{TeamName: "backend", ReviewerCount: 1})
+
+	if _, _, err := e.teams.SetCodeowners(ctx, "missing", strings.NewReader("* @u1\n")); !errors.Is(err, domain.ErrTeamNotFound) {
+		t.Errorf("unknown team: err = %v", err)
+	}
+	if _, _, err := e.teams.SetCodeowners(ctx, "backend", strings.NewReader("/api/ u2\n")); !errors.Is(err, domain.ErrInvalidCodeowners) {
+		t.Errorf("owner without @: err = %v", err)
+	}
+	if _, _, err := e.teams.SetCodeowners(ctx, "backend", strings.NewReader("/api/ @u2\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	// владелец изменённого пути назначается всегда
+	for i := range 5 {
+		id := fmt.Sprintf("pr-%d", i)
+		pr, err := e.prs.Create(ctx, NewPR{ID: id, AuthorID: "author", Files: []string{"api/handler.go"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sameIDs(pr.Reviewers, "u2") {
+			t.Fatalf("%s reviewers = %v, want the owner u2", id, pr.Reviewers)
+		}
+	}
+}
@@ -3,17 +3,23 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/ical"
 	"pr-reviewer-service/internal/repository"
 )
 
 type UserService struct {
 	repo repository.UserRepository
+	tx   repository.Transactor
 }
 
-func NewUserService(repo repository.UserRepository) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo repository.UserRepository, tx repository.Transactor) *UserService {
+	return &UserService{repo: repo, tx: tx}
 }
 
 func (s *UserService) Create(ctx context.Context, user domain.User) error {
@@ -34,3 +40,103 @@ func (s *UserService) Get(ctx context.Context, id string) (*domain.User, error)
 	}
 	return u, nil
 }
+
+// ----------------- ABSENCES -----------------
+
+func (s *UserService) AddAbsence(ctx context.Context, absence domain.Absence) (domain.Absence, error) {
+	if !absence.EndsAt.After(absence.StartsAt) {
+		return domain.Absence{}, domain.ErrInvalidAbsence
+	}
+	if _, err := s.Get(ctx, absence.UserID); err != nil {
+		return domain.Absence{}, err
+	}
+
+	return s.repo.AddAbsence(ctx, absence)
+}
+
+func (s *UserService) ListAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	if _, err := s.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAbsences(ctx, userID)
+}
+
+func (s *UserService) DeleteAbsence(ctx context.Context, userID string, absenceID int64) error {
+	return s.repo.DeleteAbsence(ctx, userID, absenceID)
+}
+
+// ImportAbsences добавляет пользователю все события из iCalendar-файла.
+// Файл импортируется целиком или не импортируется вовсе. Событие с UID,
+// уже импортированным этому пользователю, обновляет прежнее отсутствие,
+// поэтому повторная загрузка того же календаря не плодит дубликатов.
+func (s *UserService) ImportAbsences(ctx context.Context, userID string, calendar io.Reader) ([]domain.Absence, error) {
+	events, err := ical.Parse(calendar)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidCalendar, err)
+	}
+
+	var imported []domain.Absence
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.Get(ctx, userID); err != nil {
+			return err
+		}
+
+		for _, ev := range events {
+			a := domain.Absence{
+				UserID:   userID,
+				StartsAt: ev.Start,
+				EndsAt:   ev.End,
+				Reason:   strings.TrimSpace(ev.Summary),
+				UID:      ev.UID,
+			}
+			var err error
+			if a.UID == "" {
+				a, err = s.repo.AddAbsence(ctx, a)
+			} else {
+				a, err = s.repo.UpsertAbsence(ctx, a)
+			}
+			if err != nil {
+				return err
+			}
+			// тот же UID дважды в одном файле — в ответе остаётся последняя версия
+			imported = slices.DeleteFunc(imported, func(prev domain.Absence) bool { return prev.ID == a.ID })
+			imported = append(imported, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
+// ----------------- EXTERNAL LOGINS -----------------
+
+// LinkLogin связывает логин во внешней системе с пользователем (перезаписывает прежнюю связь).
+func (s *UserService) LinkLogin(ctx context.Context, l domain.ExternalLogin) error {
+	if !l.Provider.Valid() {
+		return fmt.Errorf("%w: %q", domain.ErrUnknownProvider, l.Provider)
+	}
+	if _, err := s.Get(ctx, l.UserID); err != nil {
+		return err
+	}
+	return s.repo.SetExternalLogin(ctx, l)
+}
+
+// ResolveLogin — пользователь сервиса по логину во внешней системе.
+// Если явной связи нет, логин считается user_id — так работают команды,
+// заведённые с теми же идентификаторами, что и в GitHub.
+func (s *UserService) ResolveLogin(ctx context.Context, provider domain.Provider, login string) (string, error) {
+	userID, err := s.repo.ResolveLogin(ctx, provider, login)
+	if err == nil {
+		return userID, nil
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		return "", err
+	}
+
+	if _, err := s.Get(ctx, login); err != nil {
+		return "", fmt.Errorf("%w: no user for %s login %q", domain.ErrUserNotFound, provider, login)
+	}
+	return login, nil
+}
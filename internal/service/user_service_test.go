@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+func TestAddAbsence(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "u1")
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		absence domain.Absence
+		want    error
+	}{
+		{"ends before start", domain.Absence{UserID: "u1", StartsAt: start, EndsAt: start.Add(-time.Hour)}, domain.ErrInvalidAbsence},
+		{"empty period", domain.Absence{UserID: "u1", StartsAt: start, EndsAt: start}, domain.ErrInvalidAbsence},
+		{"unknown user", domain.Absence{UserID: "nobody", StartsAt: start, EndsAt: start.Add(time.Hour)}, domain.ErrUserNotFound},
+		{"valid", domain.Absence{UserID: "u1", StartsAt: start, EndsAt: start.Add(time.Hour)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := e.users.AddAbsence(ctx, tt.absence); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	list, err := e.users.ListAbsences(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("absences = %+v", list)
+	}
+	if err := e.users.DeleteAbsence(ctx, "u1", list[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.users.ListAbsences(ctx, "nobody"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("absences of unknown user: err = %v", err)
+	}
+}
+
+func TestImportAbsences(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "u1")
+
+	ics := func(events ...string) *strings.Reader {
+		return strings.NewReader("BEGIN:VCALENDAR\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n")
+	}
+	event := func(uid, summary, start, end string) string {
+		var b strings.Builder
+		b.WriteString("BEGIN:VEVENT\r\n")
+		if uid != "" {
+			b.WriteString("UID:" + uid + "\r\n")
+		}
+		b.WriteString("SUMMARY:" + summary + "\r\nDTSTART;VALUE=DATE:" + start + "\r\nDTEND;VALUE=DATE:" + end + "\r\nEND:VEVENT\r\n")
+		return b.String()
+	}
+
+	first, err := e.users.ImportAbsences(ctx, "u1", ics(
+		event("vacation@cal", "Vacation", "20250701", "20250708"),
+		event("", "Day off", "20250801", "20250802"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 2 || first[0].UID != "vacation@cal" || first[1].UID != "" {
+		t.Fatalf("imported = %+v", first)
+	}
+
+	// повторный импорт с перенесённым отпуском обновляет его, а не дублирует
+	again, err := e.users.ImportAbsences(ctx, "u1", ics(
+		event("vacation@cal", "Vacation (moved)", "20250710", "20250717"),
+		event("sick@cal", "Sick", "20250601", "20250603"),
+		event("sick@cal", "Sick (longer)", "20250601", "20250605"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again) != 2 || again[0].ID != first[0].ID || again[1].Reason != "Sick (longer)" {
+		t.Fatalf("re-imported = %+v", again)
+	}
+
+	list, err := e.users.ListAbsences(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reasons []string
+	for _, a := range list {
+		reasons = append(reasons, a.Reason)
+	}
+	if !slices.Equal(reasons, []string{"Sick (longer)", "Vacation (moved)", "Day off"}) {
+		t.Errorf("absences after re-import = %q", reasons)
+	}
+
+	// повторяющееся событие отклоняется целиком вместе с файлом
+	_, err = e.users.ImportAbsences(ctx, "u1", ics(
+		event("new@cal", "New", "20250901", "20250902"),
+		"BEGIN:VEVENT\r\nUID:weekly@cal\r\nDTSTART;VALUE=DATE:20250905\r\nRRULE:FREQ=WEEKLY\r\nEND:VEVENT\r\n",
+	))
+	if !errors.Is(err, domain.ErrInvalidCalendar) {
+		t.Errorf("recurring event: err = %v, want ErrInvalidCalendar", err)
+	}
+	if list, _ := e.users.ListAbsences(ctx, "u1"); len(list) != 3 {
+		t.Errorf("absences after rejected import = %+v", list)
+	}
+
+	if _, err := e.users.ImportAbsences(ctx, "nobody", ics(event("x", "x", "20250901", "20250902"))); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("unknown user: err = %v", err)
+	}
+}
+
+func TestResolveLogin(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := context.Background()
+	e.addTeam(t, "backend", "u1", "octocat")
+
+	if err := e.users.LinkLogin(ctx, domain.ExternalLogin{Provider: "bitbucket", Login: "x", UserID: "u1"}); !errors.Is(err, domain.ErrUnknownProvider) {
+		t.Errorf("unknown provider: err = %v", err)
+	}
+	if err := e.users.LinkLogin(ctx, domain.ExternalLogin{Provider: domain.ProviderGitHub, Login: "x", UserID: "nobody"}); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("unknown user: err = %v", err)
+	}
+	if err := e.users.LinkLogin(ctx, domain.ExternalLogin{Provider: domain.ProviderGitHub, Login: "alice", UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		login string
+		want  string
+		err   error
+	}{
+		{"alice", "u1", nil},
+		// без явной связи логин считается user_id
+		{"octocat", "octocat", nil},
+		{"stranger", "", domain.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		got, err := e.users.ResolveLogin(ctx, domain.ProviderGitHub, tt.login)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("ResolveLogin(%q) = %q, %v; want %q, %v", tt.login, got, err, tt.want, tt.err)
+		}
+	}
+}
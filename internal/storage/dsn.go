@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Поддерживаемые базы; выбираются по схеме DB_DSN.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// DriverFor определяет базу по схеме DSN: postgres:// и postgresql:// —
+// PostgreSQL, sqlite:// — SQLite.
+func DriverFor(dsn string) (string, error) {
+	scheme, _, ok := strings.Cut(dsn, "://")
+	if !ok {
+		return "", fmt.Errorf("DSN has no scheme, expected postgres:// or sqlite://")
+	}
+	switch scheme {
+	case "postgres", "postgresql":
+		return DriverPostgres, nil
+	case "sqlite":
+		return DriverSQLite, nil
+	default:
+		return "", fmt.Errorf("unsupported DSN scheme %q", scheme)
+	}
+}
@@ -2,30 +2,179 @@ package storage
 
 import (
 	"context"
-	"os"
-	"strings"
-
-	"github.com/jackc/pgx/v5/pgxpool"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
 )
 
-func ApplyMigrations(ctx context.Context, db *pgxpool.Pool) error {
-	sqlBytes, err := os.ReadFile("migrations/001_init.sql")
+// Migration — пара скриптов одной версии схемы. Down может быть пустым:
+// такую миграцию откатить нельзя.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus — миграция и время её применения (nil — не применена).
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+var migrationFile = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// LoadMigrations читает миграции из fsys, упорядоченные по версии.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	queries := strings.Split(string(sqlBytes), ";")
-
-	for _, q := range queries {
-		q = strings.TrimSpace(q)
-		if q == "" {
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		m := migrationFile.FindStringSubmatch(e.Name())
+		if e.IsDir() || m == nil {
 			continue
 		}
-		_, execErr := db.Exec(ctx, q)
-		if execErr != nil {
-			return execErr
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", e.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d: names %q and %q differ", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s: no up script", mig.Version, mig.Name)
+		}
+		result = append(result, *mig)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// MigrationDB — база, к которой применяются миграции
+// (PostgresMigrations, SQLiteMigrations).
+type MigrationDB interface {
+	// locked выполняет fn, исключив параллельный запуск миграций,
+	// предварительно создав schema_migrations.
+	locked(ctx context.Context, fn func(c migrationConn) error) error
+}
+
+type migrationConn interface {
+	// applied — применённые версии и время применения.
+	applied(ctx context.Context) (map[int64]time.Time, error)
+	// apply выполняет скрипт миграции и добавляет (up) или удаляет (down)
+	// её запись в schema_migrations атомарно.
+	apply(ctx context.Context, m Migration, up bool) error
+}
+
+// MigrateUp применяет неприменённые миграции по возрастанию версии.
+// Возвращает число применённых.
+func MigrateUp(ctx context.Context, db MigrationDB, list []Migration) (int, error) {
+	applied := 0
+	err := db.locked(ctx, func(c migrationConn) error {
+		done, err := c.applied(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range list {
+			if _, ok := done[m.Version]; ok {
+				continue
+			}
+			if err := c.apply(ctx, m, true); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", m.Version, m.Name, err)
+			}
+			log.Printf("migration %d_%s applied", m.Version, m.Name)
+			applied++
 		}
+		return nil
+	})
+	return applied, err
+}
+
+// MigrateDown откатывает steps последних применённых миграций (steps > 0).
+// Возвращает число откаченных.
+func MigrateDown(ctx context.Context, db MigrationDB, list []Migration, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("invalid number of steps %d", steps)
+	}
+
+	byVersion := make(map[int64]Migration, len(list))
+	for _, m := range list {
+		byVersion[m.Version] = m
 	}
 
-	return nil
+	reverted := 0
+	err := db.locked(ctx, func(c migrationConn) error {
+		done, err := c.applied(ctx)
+		if err != nil {
+			return err
+		}
+		versions := make([]int64, 0, len(done))
+		for v := range done {
+			versions = append(versions, v)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		for _, v := range versions[:min(steps, len(versions))] {
+			m, ok := byVersion[v]
+			if !ok {
+				return fmt.Errorf("migration %d is applied but missing from the binary", v)
+			}
+			if m.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down script", m.Version, m.Name)
+			}
+			if err := c.apply(ctx, m, false); err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", m.Version, m.Name, err)
+			}
+			log.Printf("migration %d_%s reverted", m.Version, m.Name)
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// MigrationsStatus — все известные миграции с отметкой о применении.
+func MigrationsStatus(ctx context.Context, db MigrationDB, list []Migration) ([]MigrationStatus, error) {
+	var result []MigrationStatus
+	err := db.locked(ctx, func(c migrationConn) error {
+		done, err := c.applied(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range list {
+			st := MigrationStatus{Migration: m}
+			if at, ok := done[m.Version]; ok {
+				st.AppliedAt = &at
+			}
+			result = append(result, st)
+		}
+		return nil
+	})
+	return result, err
 }
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"pr-reviewer-service/migrations"
+)
+
+// migrationLockKey — ключ pg_advisory_lock: пока одна копия сервиса
+// применяет миграции, остальные ждут.
+const migrationLockKey int64 = 0x70725f726576 // "pr_rev"
+
+// ApplyMigrations применяет встроенные миграции PostgreSQL, которых ещё нет
+// в schema_migrations.
+func ApplyMigrations(ctx context.Context, db *pgxpool.Pool) error {
+	list, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		return err
+	}
+	_, err = MigrateUp(ctx, PostgresMigrations(db), list)
+	return err
+}
+
+type pgMigrations struct {
+	db *pgxpool.Pool
+}
+
+// PostgresMigrations — MigrationDB для PostgreSQL: каждая миграция
+// в своей транзакции, параллельные запуски ждут на advisory lock.
+func PostgresMigrations(db *pgxpool.Pool) MigrationDB {
+	return &pgMigrations{db: db}
+}
+
+// locked выполняет fn на отдельном соединении под advisory lock.
+func (p *pgMigrations) locked(ctx context.Context, fn func(c migrationConn) error) (err error) {
+	c, err := p.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Release()
+	conn := c.Conn()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// соединение возвращается в пул, поэтому блокировку снимаем явно
+		if _, unlockErr := conn.Exec(context.WithoutCancel(ctx),
+			`SELECT pg_advisory_unlock($1)`, migrationLockKey); unlockErr != nil {
+			err = errors.Join(err, fmt.Errorf("release migration lock: %w", unlockErr))
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    BIGINT PRIMARY KEY,
+			name       TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`); err != nil {
+		return err
+	}
+	return fn(pgMigrationConn{conn})
+}
+
+type pgMigrationConn struct {
+	conn *pgx.Conn
+}
+
+func (c pgMigrationConn) applied(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := c.conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	done := map[int64]time.Time{}
+	for rows.Next() {
+		var v int64
+		var at time.Time
+		if err := rows.Scan(&v, &at); err != nil {
+			return nil, err
+		}
+		done[v] = at
+	}
+	return done, rows.Err()
+}
+
+// apply выполняет скрипт по одному выражению в одной транзакции с записью
+// в schema_migrations.
+func (c pgMigrationConn) apply(ctx context.Context, m Migration, up bool) error {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // после Commit — no-op
+
+	script := m.Down
+	if up {
+		script = m.Up
+	}
+	for _, stmt := range SplitStatements(script) {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	if up {
+		_, err = tx.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name)
+	} else {
+		_, err = tx.Exec(ctx, `DELETE FROM schema_migrations WHERE version=$1`, m.Version)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
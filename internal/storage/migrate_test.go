@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+// testMigrations: 10 зависит от 2, а 2 — от 1, так что применить их
+// можно только по возрастанию версии, а откатить — только по убыванию.
+var testMigrations = fstest.MapFS{
+	"1_teams.up.sql":    {Data: []byte("CREATE TABLE teams (name TEXT PRIMARY KEY);")},
+	"1_teams.down.sql":  {Data: []byte("DROP TABLE teams;")},
+	"2_users.up.sql":    {Data: []byte("CREATE TABLE users (id TEXT PRIMARY KEY, team TEXT REFERENCES teams(name));")},
+	"2_users.down.sql":  {Data: []byte("DROP TABLE users;")},
+	"10_seed.up.sql":    {Data: []byte("INSERT INTO teams VALUES ('backend'); INSERT INTO users VALUES ('u1', 'backend');")},
+	"10_seed.down.sql":  {Data: []byte("DELETE FROM users; DELETE FROM teams;")},
+	"README.md":         {Data: []byte("not a migration")},
+	"sqlite/1_x.up.sql": {Data: []byte("broken")},
+	"3_notes.txt":       {Data: []byte("not a migration")},
+}
+
+func TestLoadMigrations(t *testing.T) {
+	list, err := LoadMigrations(testMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int64
+	for _, m := range list {
+		got = append(got, m.Version)
+	}
+	if want := []int64{1, 2, 10}; !slices.Equal(got, want) {
+		t.Errorf("versions = %v, want %v", got, want)
+	}
+	if m := list[2]; m.Name != "seed" || m.Up == "" || m.Down == "" {
+		t.Errorf("migration 10 = %+v", m)
+	}
+
+	for name, fsys := range map[string]fstest.MapFS{
+		"no up script": {"1_a.down.sql": {Data: []byte("SELECT 1;")}},
+		"names differ": {"1_a.up.sql": {Data: []byte("SELECT 1;")}, "1_b.down.sql": {Data: []byte("SELECT 1;")}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadMigrations(fsys); err == nil {
+				t.Error("no error")
+			}
+		})
+	}
+}
+
+func newMigrationDB(t *testing.T) (*sql.DB, MigrationDB, []Migration) {
+	t.Helper()
+	db, err := NewSQLite(context.Background(), "sqlite://"+filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	list, err := LoadMigrations(testMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.DB, SQLiteMigrations(db.DB), list
+}
+
+// recorded — версии и имена из schema_migrations по возрастанию.
+func recorded(t *testing.T, db *sql.DB) []string {
+	t.Helper()
+	rows, err := db.Query(`SELECT version, name FROM schema_migrations ORDER BY version`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var res []string
+	for rows.Next() {
+		var v int64
+		var name string
+		if err := rows.Scan(&v, &name); err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, name)
+	}
+	return res
+}
+
+func tableExists(t *testing.T, db *sql.DB, name string) bool {
+	t.Helper()
+	var n int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name=?`, name).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n > 0
+}
+
+func TestMigrateUp(t *testing.T) {
+	ctx := context.Background()
+	db, mdb, list := newMigrationDB(t)
+
+	// сначала только первая миграция
+	if n, err := MigrateUp(ctx, mdb, list[:1]); err != nil || n != 1 {
+		t.Fatalf("MigrateUp(1) = %d, %v", n, err)
+	}
+	if got := recorded(t, db); !slices.Equal(got, []string{"teams"}) {
+		t.Errorf("schema_migrations = %v", got)
+	}
+
+	// остальные применяются по возрастанию, уже применённая пропускается
+	if n, err := MigrateUp(ctx, mdb, list); err != nil || n != 2 {
+		t.Fatalf("MigrateUp = %d, %v; want 2 applied", n, err)
+	}
+	if got := recorded(t, db); !slices.Equal(got, []string{"teams", "users", "seed"}) {
+		t.Errorf("schema_migrations = %v", got)
+	}
+	if n, err := MigrateUp(ctx, mdb, list); err != nil || n != 0 {
+		t.Errorf("repeated MigrateUp = %d, %v; want 0", n, err)
+	}
+
+	status, err := MigrationsStatus(ctx, mdb, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, st := range status {
+		if st.AppliedAt == nil {
+			t.Errorf("migration %d is not marked applied", st.Version)
+		}
+	}
+}
+
+func TestMigrateUpRollsBackFailedRun(t *testing.T) {
+	ctx := context.Background()
+	db, mdb, list := newMigrationDB(t)
+	broken := append(slices.Clone(list), Migration{Version: 11, Name: "broken", Up: "CREATE TABLE"})
+
+	if _, err := MigrateUp(ctx, mdb, broken); err == nil {
+		t.Fatal("broken migration applied")
+	}
+	// запуск — одна транзакция: откатывается и сама schema_migrations
+	if tableExists(t, db, "schema_migrations") || tableExists(t, db, "teams") {
+		t.Error("tables survived the failed run")
+	}
+}
+
+func TestMigrateDown(t *testing.T) {
+	ctx := context.Background()
+	db, mdb, list := newMigrationDB(t)
+	if _, err := MigrateUp(ctx, mdb, list); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, steps := range []int{0, -1} {
+		if _, err := MigrateDown(ctx, mdb, list, steps); err == nil {
+			t.Errorf("MigrateDown(%d): no error", steps)
+		}
+	}
+
+	// откатывается последняя по версии, а не по порядку файлов
+	if n, err := MigrateDown(ctx, mdb, list, 1); err != nil || n != 1 {
+		t.Fatalf("MigrateDown(1) = %d, %v", n, err)
+	}
+	if got := recorded(t, db); !slices.Equal(got, []string{"teams", "users"}) {
+		t.Errorf("schema_migrations = %v", got)
+	}
+
+	// шагов больше, чем применено, — откатывается всё по убыванию
+	if n, err := MigrateDown(ctx, mdb, list, 10); err != nil || n != 2 {
+		t.Fatalf("MigrateDown(10) = %d, %v; want 2", n, err)
+	}
+	if got := recorded(t, db); len(got) != 0 {
+		t.Errorf("schema_migrations = %v", got)
+	}
+	for _, table := range []string{"teams", "users"} {
+		if tableExists(t, db, table) {
+			t.Errorf("table %s survived the rollback", table)
+		}
+	}
+	if n, err := MigrateDown(ctx, mdb, list, 1); err != nil || n != 0 {
+		t.Errorf("MigrateDown on an empty schema = %d, %v", n, err)
+	}
+}
+
+func TestMigrateDownWithoutScript(t *testing.T) {
+	ctx := context.Background()
+	db, mdb, list := newMigrationDB(t)
+	list[2].Down = ""
+	if _, err := MigrateUp(ctx, mdb, list); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MigrateDown(ctx, mdb, list, 1); err == nil {
+		t.Fatal("migration without a down script reverted")
+	}
+	if _, err := MigrateDown(ctx, mdb, list[:2], 1); err == nil {
+		t.Fatal("migration missing from the list reverted")
+	}
+	if got := recorded(t, db); !slices.Equal(got, []string{"teams", "users", "seed"}) {
+		t.Errorf("schema_migrations = %v", got)
+	}
+}
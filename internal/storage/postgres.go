@@ -3,7 +3,6 @@ package storage
 import (
 	"context"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,20 +12,22 @@ type Postgres struct {
 	Pool *pgxpool.Pool
 }
 
-func NewPostgres(ctx context.Context) (*Postgres, error) {
-	dsn := os.Getenv("DB_DSN")
-	if dsn == "" {
-		return nil, fmt.Errorf("DB_DSN is not set")
-	}
+// PoolConfig — параметры пула соединений.
+type PoolConfig struct {
+	MaxConns          int32
+	MinConns          int32
+	HealthCheckPeriod time.Duration
+}
 
+func NewPostgres(ctx context.Context, dsn string, poolCfg PoolConfig) (*Postgres, error) {
 	cfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse DSN: %w", err)
 	}
 
-	cfg.MaxConns = 10
-	cfg.MinConns = 1
-	cfg.HealthCheckPeriod = 30 * time.Second
+	cfg.MaxConns = poolCfg.MaxConns
+	cfg.MinConns = poolCfg.MinConns
+	cfg.HealthCheckPeriod = poolCfg.HealthCheckPeriod
 
 	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
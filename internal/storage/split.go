@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"regexp"
+	"strings"
+)
+
+var dollarTag = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// SplitStatements делит SQL-скрипт на выражения по ';' вне строк,
+// идентификаторов в кавычках, комментариев и $tag$-блоков (тела функций
+// и DO). Пустые выражения и выражения из одних комментариев отбрасываются.
+func SplitStatements(script string) []string {
+	var (
+		result []string
+		start  int
+		code   bool // в текущем выражении есть что-то кроме комментариев
+	)
+	flush := func(end int) {
+		if stmt := strings.TrimSpace(script[start:end]); stmt != "" && code {
+			result = append(result, stmt)
+		}
+		code = false
+	}
+
+	for i := 0; i < len(script); {
+		c := script[i]
+		switch {
+		case c == '-' && strings.HasPrefix(script[i:], "--"):
+			if end := strings.IndexByte(script[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(script)
+			}
+			continue
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			i = skipBlockComment(script, i)
+			continue
+		}
+
+		code = code || !isSpace(c)
+		switch {
+		case c == ';':
+			flush(i)
+			start = i + 1
+			i++
+		case c == '\'':
+			// E'...' допускает экранирование обратной косой чертой
+			escaped := i > 0 && (script[i-1] == 'E' || script[i-1] == 'e') &&
+				(i == 1 || !isIdent(script[i-2]))
+			i = skipQuoted(script, i, '\'', escaped)
+		case c == '"':
+			i = skipQuoted(script, i, '"', false)
+		case c == '$' && (i == 0 || !isIdent(script[i-1])):
+			tag := dollarTag.FindString(script[i:])
+			if tag == "" {
+				i++
+				continue
+			}
+			if end := strings.Index(script[i+len(tag):], tag); end >= 0 {
+				i += len(tag) + end + len(tag)
+			} else {
+				i = len(script)
+			}
+		default:
+			i++
+		}
+	}
+	flush(len(script))
+	return result
+}
+
+// skipQuoted возвращает позицию после строки, открытой кавычкой q в i.
+// Удвоенная кавычка внутри строки — экранирование.
+func skipQuoted(s string, i int, q byte, backslash bool) int {
+	for i++; i < len(s); i++ {
+		switch {
+		case backslash && s[i] == '\\':
+			i++
+		case s[i] == q:
+			if i+1 < len(s) && s[i+1] == q {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// skipBlockComment возвращает позицию после /* ... */ (комментарии вкладываются).
+func skipBlockComment(s string, i int) int {
+	depth := 0
+	for i < len(s) {
+		switch {
+		case strings.HasPrefix(s[i:], "/*"):
+			depth++
+			i += 2
+		case strings.HasPrefix(s[i:], "*/"):
+			depth--
+			i += 2
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+	return len(s)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isIdent(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "plain statements",
+			script: "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n",
+			want:   []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"},
+		},
+		{
+			name:   "trailing statement without semicolon",
+			script: "SELECT 1;\nSELECT 2\n",
+			want:   []string{"SELECT 1", "SELECT 2"},
+		},
+		{
+			name:   "empty statements",
+			script: ";;\n  ;SELECT 1;;",
+			want:   []string{"SELECT 1"},
+		},
+		{
+			name:   "$$ body",
+			script: "DO $$\nBEGIN\n    CREATE TYPE s AS ENUM ('A');\nEXCEPTION\n    WHEN duplicate_object THEN NULL;\nEND\n$$;\nSELECT 1;",
+			want: []string{
+				"DO $$\nBEGIN\n    CREATE TYPE s AS ENUM ('A');\nEXCEPTION\n    WHEN duplicate_object THEN NULL;\nEND\n$$",
+				"SELECT 1",
+			},
+		},
+		{
+			name:   "$tag$ body with $$ inside",
+			script: "CREATE FUNCTION f() RETURNS text AS $body$ SELECT '$$;'; $body$ LANGUAGE sql; SELECT 2",
+			want:   []string{"CREATE FUNCTION f() RETURNS text AS $body$ SELECT '$$;'; $body$ LANGUAGE sql", "SELECT 2"},
+		},
+		{
+			name:   "positional parameter is not a dollar quote",
+			script: "PREPARE p AS SELECT $1; SELECT 2",
+			want:   []string{"PREPARE p AS SELECT $1", "SELECT 2"},
+		},
+		{
+			name:   "doubled quotes",
+			script: "INSERT INTO t VALUES ('it''s; fine'); SELECT 1",
+			want:   []string{"INSERT INTO t VALUES ('it''s; fine')", "SELECT 1"},
+		},
+		{
+			name:   "E-string escapes",
+			script: `INSERT INTO t VALUES (E'\'; still a string'); SELECT 1`,
+			want:   []string{`INSERT INTO t VALUES (E'\'; still a string')`, "SELECT 1"},
+		},
+		{
+			name:   "backslash outside E-string",
+			script: `INSERT INTO t VALUES ('C:\'); SELECT 1`,
+			want:   []string{`INSERT INTO t VALUES ('C:\')`, "SELECT 1"},
+		},
+		{
+			name:   "quoted identifier",
+			script: `CREATE TABLE "a;b" (id INT); SELECT 1`,
+			want:   []string{`CREATE TABLE "a;b" (id INT)`, "SELECT 1"},
+		},
+		{
+			name:   "line comments",
+			script: "-- header; not a statement\nSELECT 1; -- trailing; comment\nSELECT 2 -- no semicolon; here\n",
+			want:   []string{"-- header; not a statement\nSELECT 1", "-- trailing; comment\nSELECT 2 -- no semicolon; here"},
+		},
+		{
+			name:   "nested block comments",
+			script: "/* outer /* inner; */ still; comment */ SELECT 1; SELECT /* ; */ 2;",
+			want:   []string{"/* outer /* inner; */ still; comment */ SELECT 1", "SELECT /* ; */ 2"},
+		},
+		{
+			name:   "comment-only script",
+			script: "-- nothing here;\n/* ; */\n",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitStatements(tt.script); !slices.Equal(got, tt.want) {
+				t.Errorf("SplitStatements:\n got %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
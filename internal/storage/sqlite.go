@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // драйвер database/sql "sqlite"
+
+	"pr-reviewer-service/migrations"
+)
+
+type SQLite struct {
+	DB *sql.DB
+}
+
+// NewSQLite открывает базу по DSN вида sqlite://path/to/file.db
+// (sqlite:///abs/path.db, sqlite://:memory:). Параметры после '?'
+// передаются драйверу как есть.
+func NewSQLite(ctx context.Context, dsn string) (*SQLite, error) {
+	path, params, _ := strings.Cut(strings.TrimPrefix(dsn, "sqlite://"), "?")
+	if path == "" {
+		return nil, fmt.Errorf("sqlite DSN has no path")
+	}
+
+	pragmas := "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+	if params != "" {
+		pragmas = params + "&" + pragmas
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?"+pragmas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+	// SQLite пишет в один поток; одно соединение заодно делает :memory:
+	// общей базой для всех запросов
+	db.SetMaxOpenConns(1)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+	return &SQLite{DB: db}, nil
+}
+
+func (s *SQLite) Close() {
+	if s.DB != nil {
+		s.DB.Close()
+	}
+}
+
+// SQLiteMigrationFiles — набор миграций SQLite (migrations/sqlite).
+func SQLiteMigrationFiles() fs.FS {
+	sub, err := fs.Sub(migrations.SQLiteFS, "sqlite")
+	if err != nil {
+		panic(err) // каталог встроен при сборке
+	}
+	return sub
+}
+
+// ApplySQLiteMigrations применяет встроенные миграции SQLite.
+func ApplySQLiteMigrations(ctx context.Context, db *sql.DB) error {
+	list, err := LoadMigrations(SQLiteMigrationFiles())
+	if err != nil {
+		return err
+	}
+	_, err = MigrateUp(ctx, SQLiteMigrations(db), list)
+	return err
+}
+
+type sqliteMigrations struct {
+	db *sql.DB
+}
+
+// SQLiteMigrations — MigrationDB для SQLite. Advisory lock в SQLite нет,
+// поэтому весь запуск — одна транзакция BEGIN IMMEDIATE: другой процесс
+// ждёт её конца, а при ошибке не остаётся частично применённых миграций.
+func SQLiteMigrations(db *sql.DB) MigrationDB {
+	return &sqliteMigrations{db: db}
+}
+
+func (s *sqliteMigrations) locked(ctx context.Context, fn func(c migrationConn) error) error {
+	tx, err := s.db.BeginTx(ctx, nil) // IMMEDIATE задаётся _txlock в DSN
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // после Commit — no-op
+
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			name       TEXT NOT NULL,
+			applied_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+		)`); err != nil {
+		return err
+	}
+	if err := fn(sqliteMigrationConn{tx}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type sqliteMigrationConn struct {
+	tx *sql.Tx
+}
+
+func (c sqliteMigrationConn) applied(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := c.tx.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	done := map[int64]time.Time{}
+	for rows.Next() {
+		var v int64
+		var at string
+		if err := rows.Scan(&v, &at); err != nil {
+			return nil, err
+		}
+		if done[v], err = time.Parse("2006-01-02T15:04:05.000Z", at); err != nil {
+			return nil, err
+		}
+	}
+	return done, rows.Err()
+}
+
+// apply выполняет скрипт целиком: драйвер сам разбирает несколько
+// выражений, включая тела триггеров BEGIN ... END.
+func (c sqliteMigrationConn) apply(ctx context.Context, m Migration, up bool) error {
+	script := m.Down
+	if up {
+		script = m.Up
+	}
+	if _, err := c.tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+
+	var err error
+	if up {
+		_, err = c.tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.Version, m.Name)
+	} else {
+		_, err = c.tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version=?`, m.Version)
+	}
+	return err
+}
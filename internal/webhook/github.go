@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// GitHub — обработчик вебхуков GitHub (события pull_request).
+type GitHub struct {
+	secret []byte
+	d      *Dispatcher
+}
+
+func NewGitHub(secret string, d *Dispatcher) *GitHub {
+	return &GitHub{secret: []byte(secret), d: d}
+}
+
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func (h *GitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.verify(payload, r.Header.Get("X-Hub-Signature-256")) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Header.Get("X-GitHub-Event") {
+	case "ping":
+		w.Write([]byte(`{"status":"pong"}`))
+		return
+	case "pull_request":
+	default:
+		respond(w, Event{}, errUnsupported)
+		return
+	}
+
+	var payloadEv githubPullRequestEvent
+	if err := json.Unmarshal(payload, &payloadEv); err != nil {
+		http.Error(w, "bad JSON", http.StatusBadRequest)
+		return
+	}
+
+	ev := githubEvent(payloadEv)
+	respond(w, ev, h.d.Apply(r.Context(), domain.ProviderGitHub, ev))
+}
+
+// verify сверяет X-Hub-Signature-256 ("sha256=<hex>") с HMAC-SHA256 тела.
+func (h *GitHub) verify(payload []byte, header string) bool {
+	sig, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// githubEvent сводит действие pull_request к Action.
+// PR идентифицируется как "owner/repo#number".
+func githubEvent(p githubPullRequestEvent) Event {
+	ev := Event{
+		PRID:        fmt.Sprintf("%s#%d", p.Repository.FullName, p.Number),
+		Title:       p.PullRequest.Title,
+		AuthorLogin: p.PullRequest.User.Login,
+		Draft:       p.PullRequest.Draft,
+	}
+
+	switch p.Action {
+	case "opened":
+		ev.Action = ActionOpened
+	case "closed":
+		ev.Action = ActionClosed
+		if p.PullRequest.Merged {
+			ev.Action = ActionMerged
+		}
+	case "reopened":
+		ev.Action = ActionReopened
+	case "ready_for_review":
+		ev.Action = ActionReady
+	case "converted_to_draft":
+		ev.Action = ActionDraft
+	default:
+		ev.Action = Action(p.Action)
+	}
+	return ev
+}
@@ -0,0 +1,181 @@
+package webhook_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/webhook"
+)
+
+const githubSecret = "dev-secret"
+
+func githubHeader(event string, payload []byte) http.Header {
+	mac := hmac.New(sha256.New, []byte(githubSecret))
+	mac.Write(payload)
+	h := http.Header{}
+	h.Set("X-GitHub-Event", event)
+	h.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return h
+}
+
+func TestGitHubSignature(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitHub(githubSecret, e.d)
+	payload := fixture(t, "github/pull_request_opened.json")
+
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{"missing", ""},
+		{"without prefix", githubHeader("pull_request", payload).Get("X-Hub-Signature-256")[len("sha256="):]},
+		{"not hex", "sha256=zz"},
+		{"wrong", "sha256=00"},
+		{"other secret", func() string {
+			mac := hmac.New(sha256.New, []byte("other"))
+			mac.Write(payload)
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-GitHub-Event", "pull_request")
+			header.Set("X-Hub-Signature-256", tt.signature)
+			if code, _ := serve(t, h, header, payload); code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want 401", code)
+			}
+		})
+	}
+
+	// подпись считается по телу: изменённый payload не проходит
+	header := githubHeader("pull_request", payload)
+	tampered := append([]byte{' '}, payload...)
+	if code, _ := serve(t, h, header, tampered); code != http.StatusUnauthorized {
+		t.Errorf("tampered payload status = %d, want 401", code)
+	}
+	if _, err := e.prRepo.Get(context.Background(), "acme/search#43"); err == nil {
+		t.Error("PR created from an unsigned payload")
+	}
+}
+
+func TestGitHubEvents(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitHub(githubSecret, e.d)
+	const prID = "acme/search#42"
+
+	// сценарий test/webhooks.sh: draft → ready → closed → reopened → merged
+	steps := []struct {
+		event      string
+		fixture    string
+		wantCode   int
+		wantAction string
+		wantStatus domain.PRStatus
+	}{
+		{"pull_request", "pull_request_opened_draft.json", http.StatusOK, "opened", domain.PRStatusDraft},
+		// повторная доставка opened ничего не меняет
+		{"pull_request", "pull_request_opened_draft.json", http.StatusOK, "opened", domain.PRStatusDraft},
+		{"pull_request", "pull_request_ready_for_review.json", http.StatusOK, "ready", domain.PRStatusOpen},
+		{"pull_request", "pull_request_edited.json", http.StatusAccepted, "edited", domain.PRStatusOpen},
+		{"pull_request", "pull_request_closed.json", http.StatusOK, "closed", domain.PRStatusClosed},
+		{"pull_request", "pull_request_reopened.json", http.StatusOK, "reopened", domain.PRStatusOpen},
+		{"pull_request", "pull_request_closed_merged.json", http.StatusOK, "merged", domain.PRStatusMerged},
+		// повторная доставка merge идемпотентна
+		{"pull_request", "pull_request_closed_merged.json", http.StatusOK, "merged", domain.PRStatusMerged},
+		// после merge закрыть PR нельзя
+		{"pull_request", "pull_request_closed.json", http.StatusConflict, "closed", domain.PRStatusMerged},
+	}
+	for _, s := range steps {
+		payload := fixture(t, "github/"+s.fixture)
+		code, r := serve(t, h, githubHeader(s.event, payload), payload)
+		if code != s.wantCode || r.Action != s.wantAction || r.PullRequestID != prID {
+			t.Fatalf("%s: %d %+v, want %d %s", s.fixture, code, r, s.wantCode, s.wantAction)
+		}
+		if got := e.status(t, prID); got != s.wantStatus {
+			t.Fatalf("%s: PR status = %s, want %s", s.fixture, got, s.wantStatus)
+		}
+	}
+
+	pr, err := e.prRepo.Get(context.Background(), prID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.AuthorID != "u1" || len(pr.Reviewers) != 2 {
+		t.Errorf("PR = %+v, want author u1 and two reviewers", pr)
+	}
+}
+
+// Merge на GitHub уже случился: правило min_approvals не должно
+// оставлять PR открытым и отвечать провайдеру 409.
+func TestGitHubMergeIgnoresMinApprovals(t *testing.T) {
+	e := newTestEnv(t)
+	e.requireApprovals(t, 2)
+	h := webhook.NewGitHub(githubSecret, e.d)
+	const prID = "acme/search#42"
+
+	for _, f := range []string{"pull_request_opened_draft.json", "pull_request_ready_for_review.json"} {
+		payload := fixture(t, "github/"+f)
+		if code, r := serve(t, h, githubHeader("pull_request", payload), payload); code != http.StatusOK {
+			t.Fatalf("%s: %d %+v", f, code, r)
+		}
+	}
+
+	// merge через API по-прежнему требует одобрений
+	if _, err := e.prs.Merge(context.Background(), prID); !errors.Is(err, domain.ErrNotEnoughApprovals) {
+		t.Fatalf("API merge err = %v, want ErrNotEnoughApprovals", err)
+	}
+
+	payload := fixture(t, "github/pull_request_closed_merged.json")
+	if code, r := serve(t, h, githubHeader("pull_request", payload), payload); code != http.StatusOK {
+		t.Fatalf("merged webhook: %d %+v", code, r)
+	}
+	if got := e.status(t, prID); got != domain.PRStatusMerged {
+		t.Errorf("PR status = %s, want MERGED", got)
+	}
+}
+
+func TestGitHubIgnoredDeliveries(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitHub(githubSecret, e.d)
+
+	tests := []struct {
+		name     string
+		event    string
+		payload  []byte
+		wantCode int
+		wantBody string
+	}{
+		{"ping", "ping", fixture(t, "github/ping.json"), http.StatusOK, "pong"},
+		{"other event", "push", fixture(t, "github/ping.json"), http.StatusAccepted, "ignored"},
+		// PR, о котором сервис не знает
+		{"unknown PR", "pull_request", fixture(t, "github/pull_request_closed_merged.json"), http.StatusAccepted, "ignored"},
+		{"bad JSON", "pull_request", []byte("{"), http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, r := serve(t, h, githubHeader(tt.event, tt.payload), tt.payload)
+			if code != tt.wantCode || r.Status != tt.wantBody {
+				t.Errorf("got %d %+v, want %d %q", code, r, tt.wantCode, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGitHubUnknownAuthor(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitHub(githubSecret, e.d)
+
+	payload := fixture(t, "github/pull_request_opened.json")
+	payload = []byte(strings.Replace(string(payload), `"alice-gh"`, `"mallory"`, 1))
+	code, r := serve(t, h, githubHeader("pull_request", payload), payload)
+	if code != http.StatusUnprocessableEntity || r.Status != "error" {
+		t.Errorf("got %d %+v, want 422", code, r)
+	}
+}
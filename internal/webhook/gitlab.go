@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// GitLab — обработчик вебхуков GitLab (Merge Request Hook).
+type GitLab struct {
+	token []byte
+	d     *Dispatcher
+}
+
+func NewGitLab(token string, d *Dispatcher) *GitLab {
+	return &GitLab{token: []byte(token), d: d}
+}
+
+// gitlabChange — поле из changes: значение до и после обновления.
+type gitlabChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+type gitlabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *gitlabChange `json:"draft"`
+		WorkInProgress *gitlabChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+func (h *GitLab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := []byte(r.Header.Get("X-Gitlab-Token"))
+	if subtle.ConstantTimeCompare(token, h.token) != 1 {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Header.Get("X-Gitlab-Event") != "Merge Request Hook" {
+		respond(w, Event{}, errUnsupported)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var payloadEv gitlabMergeRequestEvent
+	if err := json.Unmarshal(payload, &payloadEv); err != nil || payloadEv.ObjectKind != "merge_request" {
+		http.Error(w, "bad JSON", http.StatusBadRequest)
+		return
+	}
+
+	ev := gitlabEvent(payloadEv)
+	respond(w, ev, h.d.Apply(r.Context(), domain.ProviderGitLab, ev))
+}
+
+// gitlabEvent сводит действие MR к Action. MR идентифицируется как
+// "group/project!iid". В payload нет логина автора MR, только его id,
+// поэтому автором считается пользователь, открывший MR (user).
+func gitlabEvent(p gitlabMergeRequestEvent) Event {
+	attrs := p.ObjectAttributes
+	ev := Event{
+		PRID:        fmt.Sprintf("%s!%d", p.Project.PathWithNamespace, attrs.IID),
+		Title:       attrs.Title,
+		AuthorLogin: p.User.Username,
+		Draft:       attrs.Draft || attrs.WorkInProgress,
+	}
+
+	switch attrs.Action {
+	case "open":
+		ev.Action = ActionOpened
+	case "merge":
+		ev.Action = ActionMerged
+	case "close":
+		ev.Action = ActionClosed
+	case "reopen":
+		ev.Action = ActionReopened
+	case "update":
+		// из обновлений интересно только переключение draft;
+		// старые версии GitLab присылают work_in_progress
+		toggle := p.Changes.Draft
+		if toggle == nil {
+			toggle = p.Changes.WorkInProgress
+		}
+		switch {
+		case toggle == nil || toggle.Previous == toggle.Current:
+			ev.Action = Action(attrs.Action)
+		case toggle.Current:
+			ev.Action = ActionDraft
+		default:
+			ev.Action = ActionReady
+		}
+	default:
+		ev.Action = Action(attrs.Action)
+	}
+	return ev
+}
@@ -0,0 +1,156 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/webhook"
+)
+
+const gitlabToken = "dev-token"
+
+func gitlabHeader(token string) http.Header {
+	h := http.Header{}
+	h.Set("X-Gitlab-Event", "Merge Request Hook")
+	h.Set("X-Gitlab-Token", token)
+	return h
+}
+
+func TestGitLabToken(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitLab(gitlabToken, e.d)
+	payload := fixture(t, "gitlab/merge_request_open_draft.json")
+
+	for _, token := range []string{"", "wrong", gitlabToken + "x", strings.ToUpper(gitlabToken)} {
+		if code, _ := serve(t, h, gitlabHeader(token), payload); code != http.StatusUnauthorized {
+			t.Errorf("token %q: status = %d, want 401", token, code)
+		}
+	}
+	if _, err := e.prRepo.Get(context.Background(), "payments/billing!7"); err == nil {
+		t.Error("MR created without a valid token")
+	}
+}
+
+func TestGitLabEvents(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitLab(gitlabToken, e.d)
+	const prID = "payments/billing!7"
+
+	// сценарий test/webhooks.sh
+	steps := []struct {
+		fixture    string
+		wantCode   int
+		wantAction string
+		wantStatus domain.PRStatus
+	}{
+		{"merge_request_open_draft.json", http.StatusOK, "opened", domain.PRStatusDraft},
+		// повторная доставка open ничего не меняет
+		{"merge_request_open_draft.json", http.StatusOK, "opened", domain.PRStatusDraft},
+		{"merge_request_update_ready.json", http.StatusOK, "ready", domain.PRStatusOpen},
+		{"merge_request_update_draft.json", http.StatusOK, "draft", domain.PRStatusDraft},
+		// update без переключения draft не отслеживается
+		{"merge_request_update_description.json", http.StatusAccepted, "update", domain.PRStatusDraft},
+		{"merge_request_update_ready.json", http.StatusOK, "ready", domain.PRStatusOpen},
+		{"merge_request_close.json", http.StatusOK, "closed", domain.PRStatusClosed},
+		{"merge_request_reopen.json", http.StatusOK, "reopened", domain.PRStatusOpen},
+		{"merge_request_merge.json", http.StatusOK, "merged", domain.PRStatusMerged},
+		{"merge_request_merge.json", http.StatusOK, "merged", domain.PRStatusMerged},
+		// после merge вернуть MR в черновик нельзя
+		{"merge_request_update_draft.json", http.StatusConflict, "draft", domain.PRStatusMerged},
+	}
+	for _, s := range steps {
+		code, r := serve(t, h, gitlabHeader(gitlabToken), fixture(t, "gitlab/"+s.fixture))
+		if code != s.wantCode || r.Action != s.wantAction || r.PullRequestID != prID {
+			t.Fatalf("%s: %d %+v, want %d %s", s.fixture, code, r, s.wantCode, s.wantAction)
+		}
+		if got := e.status(t, prID); got != s.wantStatus {
+			t.Fatalf("%s: MR status = %s, want %s", s.fixture, got, s.wantStatus)
+		}
+	}
+
+	pr, err := e.prRepo.Get(context.Background(), prID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.AuthorID != "u2" || pr.Name != "Draft: Retry failed invoice exports" {
+		t.Errorf("MR = %+v, want author u2 and the title from open", pr)
+	}
+}
+
+// Старые версии GitLab сообщают о черновике через work_in_progress.
+func TestGitLabWorkInProgress(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitLab(gitlabToken, e.d)
+	const prID = "payments/billing!7"
+
+	if code, r := serve(t, h, gitlabHeader(gitlabToken), fixture(t, "gitlab/merge_request_open_draft.json")); code != http.StatusOK {
+		t.Fatalf("open: %d %+v", code, r)
+	}
+
+	payload := strings.Replace(string(fixture(t, "gitlab/merge_request_update_ready.json")),
+		`"draft": {`, `"work_in_progress": {`, 1)
+	if !strings.Contains(payload, `"work_in_progress": {`) {
+		t.Fatal("fixture has no draft change to rename")
+	}
+	code, r := serve(t, h, gitlabHeader(gitlabToken), []byte(payload))
+	if code != http.StatusOK || r.Action != "ready" {
+		t.Fatalf("update: %d %+v", code, r)
+	}
+	if got := e.status(t, prID); got != domain.PRStatusOpen {
+		t.Errorf("MR status = %s, want OPEN", got)
+	}
+}
+
+func TestGitLabMergeIgnoresMinApprovals(t *testing.T) {
+	e := newTestEnv(t)
+	e.requireApprovals(t, 2)
+	h := webhook.NewGitLab(gitlabToken, e.d)
+
+	for _, f := range []string{"merge_request_open_draft.json", "merge_request_update_ready.json", "merge_request_merge.json"} {
+		if code, r := serve(t, h, gitlabHeader(gitlabToken), fixture(t, "gitlab/"+f)); code != http.StatusOK {
+			t.Fatalf("%s: %d %+v", f, code, r)
+		}
+	}
+	if got := e.status(t, "payments/billing!7"); got != domain.PRStatusMerged {
+		t.Errorf("MR status = %s, want MERGED", got)
+	}
+}
+
+func TestGitLabIgnoredDeliveries(t *testing.T) {
+	e := newTestEnv(t)
+	h := webhook.NewGitLab(gitlabToken, e.d)
+	merge := fixture(t, "gitlab/merge_request_merge.json")
+
+	t.Run("other event", func(t *testing.T) {
+		header := gitlabHeader(gitlabToken)
+		header.Set("X-Gitlab-Event", "Push Hook")
+		if code, r := serve(t, h, header, merge); code != http.StatusAccepted || r.Status != "ignored" {
+			t.Errorf("got %d %+v, want 202 ignored", code, r)
+		}
+	})
+	t.Run("unknown MR", func(t *testing.T) {
+		if code, r := serve(t, h, gitlabHeader(gitlabToken), merge); code != http.StatusAccepted || r.Status != "ignored" {
+			t.Errorf("got %d %+v, want 202 ignored", code, r)
+		}
+	})
+	t.Run("unknown author", func(t *testing.T) {
+		payload := strings.ReplaceAll(string(fixture(t, "gitlab/merge_request_open_draft.json")), `"bob.gl"`, `"mallory"`)
+		if code, r := serve(t, h, gitlabHeader(gitlabToken), []byte(payload)); code != http.StatusUnprocessableEntity {
+			t.Errorf("got %d %+v, want 422", code, r)
+		}
+	})
+
+	for name, payload := range map[string]string{
+		"bad JSON":         "{",
+		"not an MR object": `{"object_kind":"push"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if code, _ := serve(t, h, gitlabHeader(gitlabToken), []byte(payload)); code != http.StatusBadRequest {
+				t.Errorf("status = %d, want 400", code)
+			}
+		})
+	}
+}
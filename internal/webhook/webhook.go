@@ -0,0 +1,126 @@
+// Package webhook принимает события о PR из внешних систем (GitHub, GitLab)
+// и переводит их в операции PRService.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/service"
+)
+
+// maxPayloadSize — GitHub присылает до 25 МБ, но событиям о PR хватает меньшего.
+const maxPayloadSize = 5 << 20
+
+// Action — операция над PR, к которой сводится событие провайдера.
+type Action string
+
+const (
+	ActionOpened   Action = "opened"
+	ActionMerged   Action = "merged"
+	ActionClosed   Action = "closed"
+	ActionReopened Action = "reopened"
+	ActionReady    Action = "ready"
+	ActionDraft    Action = "draft"
+)
+
+// Event — событие провайдера, приведённое к общему виду.
+type Event struct {
+	Action      Action
+	PRID        string
+	Title       string
+	AuthorLogin string
+	Draft       bool
+}
+
+// Dispatcher применяет события к PRService.
+type Dispatcher struct {
+	prs   *service.PRService
+	users *service.UserService
+}
+
+func NewDispatcher(prs *service.PRService, users *service.UserService) *Dispatcher {
+	return &Dispatcher{prs: prs, users: users}
+}
+
+// Apply выполняет операцию события. Повторная доставка opened
+// (PR уже существует) ошибкой не считается.
+func (d *Dispatcher) Apply(ctx context.Context, provider domain.Provider, ev Event) error {
+	ctx = service.WithActor(ctx, string(provider))
+
+	switch ev.Action {
+	case ActionOpened:
+		authorID, err := d.users.ResolveLogin(ctx, provider, ev.AuthorLogin)
+		if err != nil {
+			return err
+		}
+		_, err = d.prs.Create(ctx, service.NewPR{
+			ID:       ev.PRID,
+			Name:     ev.Title,
+			AuthorID: authorID,
+			Draft:    ev.Draft,
+		})
+		if errors.Is(err, domain.ErrPRExists) {
+			return nil
+		}
+		return err
+	case ActionMerged:
+		// merge уже случился у провайдера — правило min_approvals не применяется
+		_, err := d.prs.MarkMergedExternally(ctx, ev.PRID)
+		return err
+	case ActionClosed:
+		_, err := d.prs.Close(ctx, ev.PRID)
+		return err
+	case ActionReopened:
+		_, err := d.prs.Reopen(ctx, ev.PRID)
+		return err
+	case ActionReady:
+		_, err := d.prs.MarkReady(ctx, ev.PRID)
+		return err
+	case ActionDraft:
+		_, err := d.prs.MarkDraft(ctx, ev.PRID)
+		return err
+	default:
+		return errUnsupported
+	}
+}
+
+// errUnsupported — событие или действие, которое сервис не отслеживает.
+var errUnsupported = errors.New("unsupported event")
+
+// respond переводит результат Apply в HTTP-ответ провайдеру.
+// Неизвестные сервису PR и неподдерживаемые события — 202: провайдер
+// не должен считать доставку неудачной и повторять её.
+func respond(w http.ResponseWriter, ev Event, err error) {
+	w.Header().Set("Content-Type", "application/json")
+
+	status, body := http.StatusOK, map[string]string{
+		"status":          "ok",
+		"action":          string(ev.Action),
+		"pull_request_id": ev.PRID,
+	}
+
+	switch {
+	case err == nil:
+	case errors.Is(err, errUnsupported), errors.Is(err, domain.ErrPRNotFound):
+		status, body["status"] = http.StatusAccepted, "ignored"
+	case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrUserNotActive):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, domain.ErrInvalidTransition), errors.Is(err, domain.ErrNotEnoughApprovals):
+		status = http.StatusConflict
+	default:
+		log.Printf("webhook %s %s: %v", ev.Action, ev.PRID, err)
+		status = http.StatusInternalServerError
+	}
+	if err != nil && status != http.StatusAccepted {
+		body["status"] = "error"
+		body["error"] = err.Error()
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,113 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/service"
+	"pr-reviewer-service/internal/webhook"
+)
+
+// Записанные payload провайдеров, те же, что у test/webhooks.sh.
+const fixtures = "../../test/fixtures"
+
+// testEnv — диспетчер вебхуков поверх memory.Store с командой search:
+// alice-gh на GitHub — это u1, bob.gl на GitLab — u2.
+type testEnv struct {
+	prRepo repository.PRRepository
+	prs    *service.PRService
+	teams  *service.TeamService
+	d      *webhook.Dispatcher
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	ctx := context.Background()
+	s := memory.NewStore()
+	teamRepo := memory.NewTeamRepository(s)
+	userRepo := memory.NewUserRepository(s)
+	e := &testEnv{prRepo: memory.NewPRRepository(s)}
+	e.prs = service.NewPRService(e.prRepo, userRepo, teamRepo, s, memory.NewOutboxRepository(s),
+		memory.NewAssignmentEventRepository(s), domain.DefaultReviewerCount, nil)
+	e.teams = service.NewTeamService(teamRepo, s, domain.DefaultReviewerCount)
+	users := service.NewUserService(userRepo, s)
+	e.d = webhook.NewDispatcher(e.prs, users)
+
+	team := &domain.Team{Name: "search"}
+	for _, id := range []string{"u1", "u2", "u3"} {
+		team.Members = append(team.Members, domain.TeamMember{ID: id, Username: "name-" + id, IsActive: true})
+	}
+	if err := e.teams.CreateWithMembers(ctx, team); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	for _, l := range []domain.ExternalLogin{
+		{Provider: domain.ProviderGitHub, Login: "alice-gh", UserID: "u1"},
+		{Provider: domain.ProviderGitLab, Login: "bob.gl", UserID: "u2"},
+	} {
+		if err := users.LinkLogin(ctx, l); err != nil {
+			t.Fatalf("link %s login: %v", l.Provider, err)
+		}
+	}
+	return e
+}
+
+// requireApprovals включает правило min_approvals для команды search.
+func (e *testEnv) requireApprovals(t *testing.T, n int) {
+	t.Helper()
+	_, err := e.teams.UpdateSettings(context.Background(), domain.TeamSettings{
+		TeamName: "search", ReviewerStrategy: domain.StrategyRandom,
+		ReviewerCount: domain.DefaultReviewerCount, MinApprovals: n,
+	})
+	if err != nil {
+		t.Fatalf("update settings: %v", err)
+	}
+}
+
+func (e *testEnv) status(t *testing.T, prID string) domain.PRStatus {
+	t.Helper()
+	pr, err := e.prRepo.Get(context.Background(), prID)
+	if err != nil {
+		t.Fatalf("get PR %s: %v", prID, err)
+	}
+	return pr.Status
+}
+
+func fixture(t *testing.T, path string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join(fixtures, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+// reply — тело ответа respond.
+type reply struct {
+	Status        string `json:"status"`
+	Action        string `json:"action"`
+	PullRequestID string `json:"pull_request_id"`
+	Error         string `json:"error"`
+}
+
+func serve(t *testing.T, h http.Handler, header http.Header, payload []byte) (int, reply) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var r reply
+	// на 401 и 400 ответ — текст http.Error
+	_ = json.Unmarshal(rec.Body.Bytes(), &r)
+	return rec.Code, r
+}
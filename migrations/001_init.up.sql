@@ -1,5 +1,11 @@
--- Тип статуса PR
-CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED');
+-- Тип статуса PR (CREATE TYPE не поддерживает IF NOT EXISTS)
+DO $$
+BEGIN
+    CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
 
 CREATE TABLE IF NOT EXISTS teams (
     name TEXT PRIMARY KEY
@@ -33,4 +39,4 @@ CREATE TABLE IF NOT EXISTS pull_request_reviewers (
 -- Индексы
 CREATE INDEX IF NOT EXISTS idx_users_team_name ON users(team_name);
 CREATE INDEX IF NOT EXISTS idx_pr_author_id    ON pull_requests(author_id);
-CREATE INDEX IF NOT EXISTS idx_prr_user_id     ON pull_request_reviewers(user_id);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_prr_user_id     ON pull_request_reviewers(user_id);
@@ -0,0 +1,5 @@
+-- Настройки команды (стратегия выбора ревьюверов)
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name         TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    reviewer_strategy TEXT NOT NULL DEFAULT 'random'
+);
@@ -0,0 +1,3 @@
+ALTER TABLE team_settings
+    DROP COLUMN IF EXISTS reviewer_count,
+    DROP COLUMN IF EXISTS min_approvals;
@@ -0,0 +1,4 @@
+-- Число ревьюверов на PR и минимум апрувов для merge
+ALTER TABLE team_settings
+    ADD COLUMN IF NOT EXISTS reviewer_count INT NOT NULL DEFAULT 2 CHECK (reviewer_count >= 0),
+    ADD COLUMN IF NOT EXISTS min_approvals  INT NOT NULL DEFAULT 0 CHECK (min_approvals >= 0);
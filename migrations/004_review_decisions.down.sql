@@ -0,0 +1,3 @@
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS decision,
+    DROP COLUMN IF EXISTS decided_at;
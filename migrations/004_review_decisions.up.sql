@@ -0,0 +1,4 @@
+-- Решение ревьювера по PR
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS decision   TEXT CHECK (decision IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    ADD COLUMN IF NOT EXISTS decided_at TIMESTAMPTZ;
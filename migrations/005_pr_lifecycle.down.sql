@@ -0,0 +1,6 @@
+-- Значения enum в PostgreSQL не удаляются: DRAFT и CLOSED остаются в pr_status,
+-- а PR в этих статусах возвращаются в OPEN
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS closed_at;
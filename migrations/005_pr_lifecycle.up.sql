@@ -0,0 +1,6 @@
+-- Черновики и закрытые без merge PR
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'DRAFT';
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'CLOSED';
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+-- Цепочка fallback-команд для подбора ревьюверов ('*' — любой активный пользователь)
+ALTER TABLE team_settings
+    ADD COLUMN IF NOT EXISTS fallback_teams TEXT[] NOT NULL DEFAULT '{}';
+
+-- Команда, из которой ревьювер взят по fallback (NULL — команда автора)
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS fallback_team TEXT;
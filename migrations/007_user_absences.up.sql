@@ -0,0 +1,11 @@
+-- Периоды отсутствия (отпуск, болезнь): в это время пользователь не назначается ревьювером
+CREATE TABLE IF NOT EXISTS user_absences (
+    absence_id BIGSERIAL PRIMARY KEY,
+    user_id    TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at  TIMESTAMPTZ NOT NULL,
+    ends_at    TIMESTAMPTZ NOT NULL,
+    reason     TEXT NOT NULL DEFAULT '',
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_absences_user_id ON user_absences(user_id, ends_at);
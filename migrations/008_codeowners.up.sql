@@ -0,0 +1,13 @@
+-- Файл владения команды в синтаксисе CODEOWNERS (хранится как загружен)
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_name  TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    content    TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Изменённые файлы PR: по ним подбираются владельцы
+CREATE TABLE IF NOT EXISTS pull_request_files (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    path            TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path)
+);
@@ -0,0 +1,9 @@
+-- Логины во внешних системах (GitHub, ...) для сопоставления событий вебхуков с пользователями
+CREATE TABLE IF NOT EXISTS external_logins (
+    provider TEXT NOT NULL,
+    login    TEXT NOT NULL,
+    user_id  TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_logins_user_id ON external_logins(user_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_pr_merged_at;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS first_decided_at;
@@ -0,0 +1,22 @@
+-- Время назначения ревьювера и его первого решения — для метрик скорости ревью.
+-- Уже существующим назначениям проставляется время создания PR.
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at      TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS first_decided_at TIMESTAMPTZ;
+
+UPDATE pull_request_reviewers prr
+   SET assigned_at = pr.created_at
+  FROM pull_requests pr
+ WHERE pr.pull_request_id = prr.pull_request_id
+   AND prr.assigned_at IS NULL;
+
+UPDATE pull_request_reviewers
+   SET first_decided_at = decided_at
+ WHERE first_decided_at IS NULL
+   AND decided_at IS NOT NULL;
+
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN assigned_at SET DEFAULT NOW(),
+    ALTER COLUMN assigned_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pr_merged_at ON pull_requests(merged_at) WHERE merged_at IS NOT NULL;
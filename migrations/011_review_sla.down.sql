@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS review_escalations;
+
+ALTER TABLE team_settings
+    DROP COLUMN IF EXISTS review_sla_hours,
+    DROP COLUMN IF EXISTS escalation_hours;
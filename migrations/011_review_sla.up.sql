@@ -0,0 +1,20 @@
+-- SLA ревью: через review_sla_hours без решения ревьюверу уходит напоминание,
+-- через escalation_hours его заменяют другим (0 — выключено)
+ALTER TABLE team_settings
+    ADD COLUMN IF NOT EXISTS review_sla_hours INT NOT NULL DEFAULT 0 CHECK (review_sla_hours >= 0),
+    ADD COLUMN IF NOT EXISTS escalation_hours INT NOT NULL DEFAULT 0 CHECK (escalation_hours >= 0);
+
+-- Напоминания и переназначения по SLA. assigned_at — назначение, к которому
+-- относится запись: после переназначения отсчёт начинается заново
+CREATE TABLE IF NOT EXISTS review_escalations (
+    escalation_id   BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL REFERENCES users(user_id),
+    kind            TEXT NOT NULL CHECK (kind IN ('reminder', 'reassign')),
+    assigned_at     TIMESTAMPTZ NOT NULL,
+    replaced_by     TEXT REFERENCES users(user_id),
+    reason          TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_escalations_pr ON review_escalations(pull_request_id, user_id);
@@ -0,0 +1,14 @@
+-- Outbox уведомлений: пишется в той же транзакции, что и изменения PR
+-- и ревьюверов, фоновый relay доставляет записи и отмечает sent_at
+CREATE TABLE IF NOT EXISTS outbox (
+    event_id        BIGSERIAL PRIMARY KEY,
+    kind            TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    sent_at         TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at) WHERE sent_at IS NULL;
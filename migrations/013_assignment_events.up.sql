@@ -0,0 +1,21 @@
+-- Журнал назначений ревьюверов: кто, на какой PR, когда и почему назначен
+-- или снят. Только добавление: UPDATE и DELETE игнорируются правилами.
+-- Внешнего ключа на PR нет — журнал переживает удаление PR
+CREATE TABLE IF NOT EXISTS assignment_events (
+    event_id        BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    action          TEXT NOT NULL CHECK (action IN ('assigned', 'unassigned')),
+    reason          TEXT NOT NULL,
+    detail          TEXT NOT NULL DEFAULT '',
+    actor           TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_events_pr ON assignment_events(pull_request_id, event_id);
+
+CREATE OR REPLACE RULE assignment_events_no_update AS
+    ON UPDATE TO assignment_events DO INSTEAD NOTHING;
+
+CREATE OR REPLACE RULE assignment_events_no_delete AS
+    ON DELETE TO assignment_events DO INSTEAD NOTHING;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_absences_user_uid;
+ALTER TABLE user_absences DROP COLUMN IF EXISTS uid;
@@ -0,0 +1,2 @@
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS assignment_seq;
+DROP SEQUENCE IF EXISTS pull_request_reviewers_assignment_seq;
@@ -0,0 +1,27 @@
+-- Порядок назначения ревьюверов: assigned_at внутри одной транзакции
+-- одинаковый (NOW()), поэтому нужен отдельный счётчик. Существующим
+-- назначениям он проставляется по assigned_at, затем по user_id.
+CREATE SEQUENCE IF NOT EXISTS pull_request_reviewers_assignment_seq;
+
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS assignment_seq BIGINT;
+
+WITH ordered AS (
+    SELECT pull_request_id, user_id,
+           row_number() OVER (ORDER BY assigned_at, user_id) AS n
+      FROM pull_request_reviewers
+     WHERE assignment_seq IS NULL
+)
+UPDATE pull_request_reviewers prr
+   SET assignment_seq = o.n + (SELECT COALESCE(MAX(assignment_seq), 0) FROM pull_request_reviewers)
+  FROM ordered o
+ WHERE prr.pull_request_id = o.pull_request_id
+   AND prr.user_id = o.user_id;
+
+SELECT setval('pull_request_reviewers_assignment_seq',
+              (SELECT COALESCE(MAX(assignment_seq), 0) + 1 FROM pull_request_reviewers), false);
+
+ALTER SEQUENCE pull_request_reviewers_assignment_seq OWNED BY pull_request_reviewers.assignment_seq;
+
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN assignment_seq SET DEFAULT nextval('pull_request_reviewers_assignment_seq'),
+    ALTER COLUMN assignment_seq SET NOT NULL;
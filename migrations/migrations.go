@@ -0,0 +1,15 @@
+// Package migrations встраивает SQL-миграции в бинарник, чтобы он
+// не зависел от рабочего каталога.
+//
+// Файлы называются NNN_name.up.sql и NNN_name.down.sql; NNN — версия,
+// миграции применяются по возрастанию версии. В корне — миграции
+// PostgreSQL, в sqlite/ — отдельный набор для SQLite.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
+
+//go:embed sqlite/*.sql
+var SQLiteFS embed.FS
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS assignment_events;
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS review_escalations;
+DROP TABLE IF EXISTS external_logins;
+DROP TABLE IF EXISTS user_absences;
+DROP TABLE IF EXISTS pull_request_files;
+DROP TABLE IF EXISTS pull_request_reviewers;
+DROP TABLE IF EXISTS pull_requests;
+DROP TABLE IF EXISTS team_codeowners;
+DROP TABLE IF EXISTS team_settings;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,129 @@
+-- Схема для SQLite: то же, что миграции PostgreSQL 001–013, одним файлом.
+-- Время хранится в TEXT фиксированной ширины (2006-01-02T15:04:05.000000000Z),
+-- чтобы строки сравнивались так же, как моменты времени. Списки — JSON-массивы.
+
+CREATE TABLE IF NOT EXISTS teams (
+    name TEXT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS users (
+    user_id   TEXT PRIMARY KEY,
+    username  TEXT NOT NULL,
+    team_name TEXT NOT NULL REFERENCES teams(name) ON DELETE RESTRICT,
+    is_active INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name         TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    reviewer_strategy TEXT NOT NULL DEFAULT 'random',
+    reviewer_count    INTEGER NOT NULL DEFAULT 2 CHECK (reviewer_count >= 0),
+    min_approvals     INTEGER NOT NULL DEFAULT 0 CHECK (min_approvals >= 0),
+    fallback_teams    TEXT NOT NULL DEFAULT '[]',
+    review_sla_hours  INTEGER NOT NULL DEFAULT 0 CHECK (review_sla_hours >= 0),
+    escalation_hours  INTEGER NOT NULL DEFAULT 0 CHECK (escalation_hours >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS team_codeowners (
+    team_name  TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    content    TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pull_requests (
+    pull_request_id   TEXT PRIMARY KEY,
+    pull_request_name TEXT NOT NULL,
+    author_id         TEXT NOT NULL REFERENCES users(user_id),
+    status            TEXT NOT NULL DEFAULT 'OPEN' CHECK (status IN ('DRAFT', 'OPEN', 'MERGED', 'CLOSED')),
+    created_at        TEXT NOT NULL,
+    merged_at         TEXT,
+    closed_at         TEXT
+);
+
+CREATE TABLE IF NOT EXISTS pull_request_reviewers (
+    pull_request_id  TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id          TEXT NOT NULL REFERENCES users(user_id),
+    decision         TEXT CHECK (decision IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    decided_at       TEXT,
+    fallback_team    TEXT,
+    assigned_at      TEXT NOT NULL,
+    first_decided_at TEXT,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS pull_request_files (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    path            TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path)
+);
+
+CREATE TABLE IF NOT EXISTS user_absences (
+    absence_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at  TEXT NOT NULL,
+    ends_at    TEXT NOT NULL,
+    reason     TEXT NOT NULL DEFAULT '',
+    CHECK (ends_at > starts_at)
+);
+
+CREATE TABLE IF NOT EXISTS external_logins (
+    provider TEXT NOT NULL,
+    login    TEXT NOT NULL,
+    user_id  TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+
+CREATE TABLE IF NOT EXISTS review_escalations (
+    escalation_id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL REFERENCES users(user_id),
+    kind            TEXT NOT NULL CHECK (kind IN ('reminder', 'reassign')),
+    assigned_at     TEXT NOT NULL,
+    replaced_by     TEXT REFERENCES users(user_id),
+    reason          TEXT NOT NULL,
+    created_at      TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS outbox (
+    event_id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    kind            TEXT NOT NULL,
+    payload         TEXT NOT NULL,
+    created_at      TEXT NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TEXT NOT NULL,
+    last_error      TEXT,
+    sent_at         TEXT
+);
+
+CREATE TABLE IF NOT EXISTS assignment_events (
+    event_id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    pull_request_id TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    action          TEXT NOT NULL CHECK (action IN ('assigned', 'unassigned')),
+    reason          TEXT NOT NULL,
+    detail          TEXT NOT NULL DEFAULT '',
+    actor           TEXT NOT NULL,
+    created_at      TEXT NOT NULL
+);
+
+-- Журнал назначений только дополняется
+CREATE TRIGGER IF NOT EXISTS assignment_events_no_update
+BEFORE UPDATE ON assignment_events
+BEGIN
+    SELECT RAISE(IGNORE);
+END;
+
+CREATE TRIGGER IF NOT EXISTS assignment_events_no_delete
+BEFORE DELETE ON assignment_events
+BEGIN
+    SELECT RAISE(IGNORE);
+END;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_name         ON users(team_name);
+CREATE INDEX IF NOT EXISTS idx_pr_author_id            ON pull_requests(author_id);
+CREATE INDEX IF NOT EXISTS idx_pr_merged_at            ON pull_requests(merged_at) WHERE merged_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_prr_user_id             ON pull_request_reviewers(user_id);
+CREATE INDEX IF NOT EXISTS idx_absences_user_id        ON user_absences(user_id, ends_at);
+CREATE INDEX IF NOT EXISTS idx_external_logins_user_id ON external_logins(user_id);
+CREATE INDEX IF NOT EXISTS idx_escalations_pr          ON review_escalations(pull_request_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_pending          ON outbox(next_attempt_at) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_assignment_events_pr    ON assignment_events(pull_request_id, event_id);
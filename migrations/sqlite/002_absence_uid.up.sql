@@ -0,0 +1,5 @@
+-- UID события календаря: повторный импорт .ics обновляет отсутствие, а не дублирует его.
+-- У заведённых вручную uid пуст (NULL), уникальность на них не распространяется
+ALTER TABLE user_absences ADD COLUMN uid TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_absences_user_uid ON user_absences(user_id, uid);
@@ -0,0 +1,6 @@
+-- Позиции очередей round-robin: переживают рестарт и общие для всех реплик.
+-- queue — имя команды или '*' из цепочки fallback, поэтому без внешнего ключа
+CREATE TABLE IF NOT EXISTS round_robin_cursors (
+    queue      TEXT PRIMARY KEY,
+    next_index INTEGER NOT NULL DEFAULT 0
+);
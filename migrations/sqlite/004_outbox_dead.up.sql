@@ -0,0 +1,6 @@
+-- Записи, которые relay перестал повторять: получатель отверг уведомление
+-- или кончились попытки. Остаются в таблице для разбора, в очередь не возвращаются
+ALTER TABLE outbox ADD COLUMN dead_at TEXT;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at) WHERE sent_at IS NULL AND dead_at IS NULL;
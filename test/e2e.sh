@@ -47,8 +47,8 @@ curl -s -X POST $API/pullRequest/merge -H "Content-Type: application/json" -d '{
 # 6. pullRequest/reassign (should fail - merged)
 section "6) Reassign on merged PR (expected error)"
 curl -s -X POST $API/pullRequest/reassign -H "Content-Type: application/json" -d '{
-  "id":"pr1",
-  "reviewerId":"u2"
+  "pull_request_id":"pr1",
+  "old_user_id":"u2"
 }'
 
 # 7. Create second PR
@@ -61,9 +61,9 @@ curl -s -X POST $API/pullRequest/create -H "Content-Type: application/json" -d '
 
 # 8. Reassign reviewer on open PR
 section "8) Reassign reviewer on pr2"
-curl -s -X POST $API/pullRequest/reassign -H "Content-Type: application/json" -d '{
-  "id":"pr2",
-  "reviewerId":"u2"
+curl -s -X POST $API/pullRequest/reassign -H "Content-Type: application/json" -H "X-Actor: e2e" -d '{
+  "pull_request_id":"pr2",
+  "old_user_id":"u2"
 }'
 
 # 9. Deactivate team
@@ -76,5 +76,21 @@ curl -s -X POST $API/team/deactivate -H "Content-Type: application/json" -d '{
 section "10) Stats"
 curl -s "$API/stats"
 
+# 11. Stats for one team and period
+section "11) Stats for backend since 2020-01-01"
+curl -s "$API/stats?team=backend&from=2020-01-01T00:00:00Z"
+
+# 12. Review turnaround
+section "12) Review turnaround"
+curl -s "$API/stats/turnaround"
+
+# 13. Reviewer fairness
+section "13) Reviewer fairness"
+curl -s "$API/stats/fairness?team=backend"
+
+# 14. Assignment history
+section "14) Assignment history of pr2"
+curl -s "$API/pullRequest/history?pull_request_id=pr2"
+
 echo ""
 echo "=== E2E DONE ==="
\ No newline at end of file
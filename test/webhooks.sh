@@ -0,0 +1,114 @@
+#!/bin/bash
+
+# Прогон вебхуков по записанным payload-фикстурам (сеть не нужна, только запущенный сервис).
+# Секреты должны совпадать с GITHUB_WEBHOOK_SECRET и GITLAB_WEBHOOK_TOKEN сервиса.
+
+echo "=== Starting webhook tests ==="
+
+API="http://localhost:8080"
+SECRET="${GITHUB_WEBHOOK_SECRET:-dev-secret}"
+TOKEN="${GITLAB_WEBHOOK_TOKEN:-dev-token}"
+FIXTURES="$(dirname "$0")/fixtures"
+
+function section() {
+  echo ""
+  echo "----------------------------------"
+  echo "$1"
+  echo "----------------------------------"
+}
+
+# github <event> <fixture> — отправить фикстуру с корректной подписью
+function github() {
+  local sig
+  sig="sha256=$(openssl dgst -sha256 -hmac "$SECRET" -hex < "$FIXTURES/github/$2" | sed 's/^.* //')"
+  curl -s -X POST $API/webhooks/github \
+    -H "Content-Type: application/json" \
+    -H "X-GitHub-Event: $1" \
+    -H "X-Hub-Signature-256: $sig" \
+    --data-binary @"$FIXTURES/github/$2"
+}
+
+# gitlab <fixture> — отправить фикстуру Merge Request Hook с токеном
+function gitlab() {
+  curl -s -X POST $API/webhooks/gitlab \
+    -H "Content-Type: application/json" \
+    -H "X-Gitlab-Event: Merge Request Hook" \
+    -H "X-Gitlab-Token: $TOKEN" \
+    --data-binary @"$FIXTURES/gitlab/$1"
+}
+
+section "0) Create team and link GitHub login alice-gh -> u1"
+curl -s -X POST $API/team/add -H "Content-Type: application/json" -d '{
+  "team_name": "search",
+  "members": [
+    {"user_id":"u1","username":"Alice","is_active":true},
+    {"user_id":"u2","username":"Bob","is_active":true},
+    {"user_id":"u3","username":"Eve","is_active":true}
+  ]
+}'
+curl -s -X POST $API/users/externalLogin -H "Content-Type: application/json" -d '{
+  "user_id": "u1", "provider": "github", "login": "alice-gh"
+}'
+
+section "1) ping"
+github ping ping.json
+
+section "2) Bad signature (expected 401)"
+curl -s -X POST $API/webhooks/github \
+  -H "Content-Type: application/json" \
+  -H "X-GitHub-Event: pull_request" \
+  -H "X-Hub-Signature-256: sha256=00" \
+  --data-binary @"$FIXTURES/github/pull_request_opened.json"
+
+section "3) opened -> acme/search#43 OPEN"
+github pull_request pull_request_opened.json
+
+section "4) opened (draft) -> acme/search#42 DRAFT, redelivery is a no-op"
+github pull_request pull_request_opened_draft.json
+github pull_request pull_request_opened_draft.json
+
+section "5) ready_for_review -> OPEN"
+github pull_request pull_request_ready_for_review.json
+
+section "6) closed -> CLOSED"
+github pull_request pull_request_closed.json
+
+section "7) reopened -> OPEN"
+github pull_request pull_request_reopened.json
+
+section "8) closed + merged -> MERGED"
+github pull_request pull_request_closed_merged.json
+
+section "9) edited (ignored)"
+github pull_request pull_request_edited.json
+
+section "10) Link GitLab login bob.gl -> u2"
+curl -s -X POST $API/users/externalLogin -H "Content-Type: application/json" -d '{
+  "user_id": "u2", "provider": "gitlab", "login": "bob.gl"
+}'
+
+section "11) Wrong GitLab token (expected 401)"
+curl -s -X POST $API/webhooks/gitlab \
+  -H "Content-Type: application/json" \
+  -H "X-Gitlab-Event: Merge Request Hook" \
+  -H "X-Gitlab-Token: wrong" \
+  --data-binary @"$FIXTURES/gitlab/merge_request_open_draft.json"
+
+section "12) open (draft) -> payments/billing!7 DRAFT"
+gitlab merge_request_open_draft.json
+
+section "13) update: draft -> ready (OPEN), ready -> draft (DRAFT)"
+gitlab merge_request_update_ready.json
+gitlab merge_request_update_draft.json
+
+section "14) update without draft toggle (ignored)"
+gitlab merge_request_update_description.json
+
+section "15) ready, close, reopen, merge"
+gitlab merge_request_update_ready.json
+gitlab merge_request_close.json
+gitlab merge_request_reopen.json
+gitlab merge_request_merge.json
+
+echo ""
+echo "=== WEBHOOKS DONE ==="
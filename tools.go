@@ -0,0 +1,8 @@
+//go:build tools
+
+// Package tools фиксирует в go.mod версии утилит для go generate.
+package tools
+
+import (
+	_ "github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen"
+)